@@ -1 +1,58 @@
 var hooks = require('hooks');
+var http = require('http');
+
+// The identifier lookup redirects to the organisation, which dredd does not follow, so the organisation is asked for directly
+hooks.before('/organisations > Resolves an external authority identifier to an Organisation. > 200', function (transaction) {
+    transaction.fullPath += '&resolveAliases=true';
+    transaction.request.uri += '&resolveAliases=true';
+});
+
+// The industry classification listing shares its operation with the identifier lookup, so it is requested after the lookup
+hooks.after('/organisations > Resolves an external authority identifier to an Organisation. > 200', function (transaction, done) {
+    var path = '/organisations?industryClassification=38ee195d-ebdd-48a9-af4b-c8a322e7b04d';
+    http.get({host: transaction.host, port: transaction.port, path: path}, function (res) {
+        var body = '';
+        res.on('data', function (chunk) {
+            body += chunk;
+        });
+        res.on('end', function () {
+            try {
+                var organisations = JSON.parse(body).organisations;
+                if (res.statusCode !== 200 || organisations.length !== 1 || organisations[0].prefLabel !== 'The Spot') {
+                    transaction.fail = path + ' returned ' + res.statusCode + ': ' + body;
+                }
+            } catch (err) {
+                transaction.fail = path + ' returned invalid JSON: ' + body;
+            }
+            done();
+        });
+    }).on('error', function (err) {
+        transaction.fail = path + ' failed: ' + err.message;
+        done();
+    });
+});
+
+// GraphQL answers every query with a 200, so the organisation and its parent, resolved from their fixtures, are checked
+hooks.after('/graphql > Answers GraphQL queries over organisations and their relations. > 200', function (transaction) {
+    try {
+        var response = JSON.parse(transaction.real.body);
+        var organisation = response.data.organisation;
+        if (response.errors || organisation.prefLabel !== 'The Spot' || organisation.parentOrganisation.prefLabel !== 'The Spot Group') {
+            transaction.fail = 'unexpected GraphQL response: ' + transaction.real.body;
+        }
+    } catch (err) {
+        transaction.fail = 'invalid GraphQL response: ' + transaction.real.body;
+    }
+});
+
+// Exports are streamed as NDJSON, which dredd cannot validate against a schema, so the first line is checked to be the organisation
+hooks.after('/organisations/export > Streams the Organisations with the given UUIDs as NDJSON. > 200', function (transaction) {
+    try {
+        var organisation = JSON.parse(transaction.real.body.split('\n')[0]);
+        if (organisation.error || organisation.id !== 'http://api.ft.com/things/100483aa-47c3-41c9-9f53-9a5aa5450fd3' || organisation.prefLabel !== 'The Spot') {
+            transaction.fail = 'unexpected export: ' + transaction.real.body;
+        }
+    } catch (err) {
+        transaction.fail = 'invalid export: ' + transaction.real.body;
+    }
+});
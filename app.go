@@ -11,24 +11,37 @@ import (
 	"github.com/Financial-Times/public-organisations-api/v3/organisations"
 	status "github.com/Financial-Times/service-status-go/httphandlers"
 
-	"fmt"
-	"strconv"
 	"time"
 
+	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 	cli "github.com/jawher/mow.cli"
 	metrics "github.com/rcrowley/go-metrics"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 )
 
-var httpClient = http.Client{
-	Transport: &http.Transport{
-		DialContext: (&net.Dialer{
-			Timeout: 15 * time.Second,
-		}).DialContext,
-		MaxIdleConnsPerHost: 128,
-		IdleConnTimeout:     60 * time.Second,
-	},
+// defaultConceptsAPITimeout bounds requests to public-concepts-api, including reading their body
+const defaultConceptsAPITimeout = 10 * time.Second
+
+var httpTransport = &http.Transport{
+	DialContext: (&net.Dialer{
+		Timeout: 15 * time.Second,
+	}).DialContext,
+	MaxIdleConnsPerHost: 128,
+	IdleConnTimeout:     60 * time.Second,
+}
+
+// newHTTPClient returns a client requesting public-concepts-api that gives up after the timeout. It does not follow
+// redirects, which the organisations handler follows itself to learn the aliases of the canonical concept.
+func newHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: httpTransport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
 }
 
 func main() {
@@ -68,6 +81,119 @@ func main() {
 		Desc:   "Public concepts API endpoint URL.",
 		EnvVar: "CONCEPTS_API",
 	})
+	conceptsAPITimeout := app.String(cli.StringOpt{
+		Name:   "concepts-api-timeout",
+		Value:  defaultConceptsAPITimeout.String(),
+		Desc:   "Duration after which a request to public-concepts-api is given up",
+		EnvVar: "CONCEPTS_API_TIMEOUT",
+	})
+	cachePolicyOK := app.String(cli.StringOpt{
+		Name:   "cache-policy-ok",
+		Value:  "",
+		Desc:   "Cache-Control directives for found organisations, e.g. 'max-age=30s, s-maxage=5m, stale-while-revalidate=1m, stale-if-error=24h'. Defaults to max-age set by --cache-duration",
+		EnvVar: "CACHE_POLICY_OK",
+	})
+	cachePolicyRedirect := app.String(cli.StringOpt{
+		Name:   "cache-policy-redirect",
+		Value:  "",
+		Desc:   "Cache-Control directives for redirects to the canonical organisation. Defaults to max-age set by --cache-duration",
+		EnvVar: "CACHE_POLICY_REDIRECT",
+	})
+	cachePolicyNotFound := app.String(cli.StringOpt{
+		Name:   "cache-policy-not-found",
+		Value:  "",
+		Desc:   "Cache-Control directives for organisations that are not found. Not cached by default",
+		EnvVar: "CACHE_POLICY_NOT_FOUND",
+	})
+	cachePolicyDeprecated := app.String(cli.StringOpt{
+		Name:   "cache-policy-deprecated",
+		Value:  "",
+		Desc:   "Cache-Control directives for deprecated organisations. Defaults to max-age set by --cache-duration",
+		EnvVar: "CACHE_POLICY_DEPRECATED",
+	})
+	preferredParentType := app.String(cli.StringOpt{
+		Name:   "preferred-parent-type",
+		Value:  "",
+		Desc:   "Type URI of the parent used as parentOrganisation when an organisation has several parents, e.g. http://www.ft.com/ontology/company/PublicCompany. The first parent returned by public-concepts-api is used if empty or none match",
+		EnvVar: "PREFERRED_PARENT_TYPE",
+	})
+	redirectDeprecated := app.Bool(cli.BoolOpt{
+		Name:   "redirect-deprecated",
+		Value:  false,
+		Desc:   "Redirect requests for deprecated organisations that have been replaced to their successor",
+		EnvVar: "REDIRECT_DEPRECATED",
+	})
+	grpcPort := app.String(cli.StringOpt{
+		Name:   "grpc-port",
+		Value:  "",
+		Desc:   "Port the gRPC Organisations and health services listen on. No gRPC server is started if empty",
+		EnvVar: "GRPC_PORT",
+	})
+	graphQLMaxDepth := app.Int(cli.IntOpt{
+		Name:   "graphql-max-depth",
+		Value:  organisations.DefaultGraphQLMaxDepth,
+		Desc:   "Deepest nesting of fields accepted in /graphql queries",
+		EnvVar: "GRAPHQL_MAX_DEPTH",
+	})
+	graphQLMaxComplexity := app.Int(cli.IntOpt{
+		Name:   "graphql-max-complexity",
+		Value:  organisations.DefaultGraphQLMaxComplexity,
+		Desc:   "Highest complexity accepted in /graphql queries, counting every field once and fields resolving organisations ten times",
+		EnvVar: "GRAPHQL_MAX_COMPLEXITY",
+	})
+	graphQLMaxFetches := app.Int(cli.IntOpt{
+		Name:   "graphql-max-fetches",
+		Value:  organisations.DefaultGraphQLMaxFetches,
+		Desc:   "Most organisations a /graphql query fetches, however long the lists of parents and subsidiaries it resolves",
+		EnvVar: "GRAPHQL_MAX_FETCHES",
+	})
+	exportConcurrency := app.Int(cli.IntOpt{
+		Name:   "export-concurrency",
+		Value:  organisations.DefaultExportConcurrency,
+		Desc:   "Number of organisations POST /organisations/export fetches from public-concepts-api at once",
+		EnvVar: "EXPORT_CONCURRENCY",
+	})
+	recordFixtures := app.String(cli.StringOpt{
+		Name:   "record-fixtures",
+		Value:  "",
+		Desc:   "Directory the public-concepts-api responses of concepts are recorded into, for --replay-fixtures to serve later",
+		EnvVar: "RECORD_FIXTURES",
+	})
+	replayFixtures := app.String(cli.StringOpt{
+		Name:   "replay-fixtures",
+		Value:  "",
+		Desc:   "Directory of recorded public-concepts-api responses to serve concepts from instead of --publicConceptsApiURL",
+		EnvVar: "REPLAY_FIXTURES",
+	})
+	organisationCacheTTL := app.String(cli.StringOpt{
+		Name:   "organisation-cache-ttl",
+		Value:  "0s",
+		Desc:   "Duration mapped organisations are kept in the in-memory cache for. 0s disables the cache",
+		EnvVar: "ORGANISATION_CACHE_TTL",
+	})
+	organisationCacheMaxEntries := app.Int(cli.IntOpt{
+		Name:   "organisation-cache-max-entries",
+		Value:  10000,
+		Desc:   "Most organisations kept in the in-memory cache, the least recently used being evicted first",
+		EnvVar: "ORGANISATION_CACHE_MAX_ENTRIES",
+	})
+	invalidationSource := app.String(cli.StringOpt{
+		Name:   "invalidation-source",
+		Value:  "",
+		Desc:   "Where concept change notifications that evict cached organisations come from: 'http' (POST /__invalidate on --invalidation-address), '-' for stdin or a file path",
+		EnvVar: "INVALIDATION_SOURCE",
+	})
+	invalidationAddress := app.String(cli.StringOpt{
+		Name:   "invalidation-address",
+		Value:  "localhost:8090",
+		Desc:   "Address POST /__invalidate listens on with --invalidation-source=http, apart from the public API",
+		EnvVar: "INVALIDATION_ADDRESS",
+	})
+
+	registerLookupCommands(app, lookupConfig{
+		publicConceptsApiURL: publicConceptsApiURL,
+		preferredParentType:  preferredParentType,
+	})
 
 	logger.InitLogger(*appSystemCode, *logLevel)
 	logger.Infof("[Startup] public-organisations-api is starting ")
@@ -75,7 +201,28 @@ func main() {
 	app.Action = func() {
 
 		log.Infof("public-organisations-api will listen on port: %s", *port)
-		runServer(*port, *cacheDuration, *env, *publicConceptsApiURL)
+		runServer(serverConfig{
+			port:                  *port,
+			grpcPort:              *grpcPort,
+			cacheDuration:         *cacheDuration,
+			cachePolicyOK:         *cachePolicyOK,
+			cachePolicyRedirect:   *cachePolicyRedirect,
+			cachePolicyNotFound:   *cachePolicyNotFound,
+			cachePolicyDeprecated: *cachePolicyDeprecated,
+			env:                   *env,
+			publicConceptsApiURL:  *publicConceptsApiURL,
+			conceptsAPITimeout:    *conceptsAPITimeout,
+			organisationCacheTTL:  *organisationCacheTTL,
+			organisationCacheSize: *organisationCacheMaxEntries,
+			invalidationSource:    *invalidationSource,
+			invalidationAddress:   *invalidationAddress,
+			preferredParentType:   *preferredParentType,
+			redirectDeprecated:    *redirectDeprecated,
+			graphQLLimits:         organisations.GraphQLLimits{MaxDepth: *graphQLMaxDepth, MaxComplexity: *graphQLMaxComplexity, MaxFetches: *graphQLMaxFetches},
+			exportConcurrency:     *exportConcurrency,
+			recordFixtures:        *recordFixtures,
+			replayFixtures:        *replayFixtures,
+		})
 
 	}
 	log.SetFormatter(&log.TextFormatter{DisableColors: true})
@@ -84,17 +231,70 @@ func main() {
 	app.Run(os.Args)
 }
 
-func runServer(port string, cacheDuration string, env string, publicConceptsApiURL string) {
+type serverConfig struct {
+	port                  string
+	grpcPort              string
+	cacheDuration         string
+	cachePolicyOK         string
+	cachePolicyRedirect   string
+	cachePolicyNotFound   string
+	cachePolicyDeprecated string
+	env                   string
+	publicConceptsApiURL  string
+	conceptsAPITimeout    string
+	organisationCacheTTL  string
+	organisationCacheSize int
+	invalidationSource    string
+	invalidationAddress   string
+	preferredParentType   string
+	redirectDeprecated    bool
+	graphQLLimits         organisations.GraphQLLimits
+	exportConcurrency     int
+	recordFixtures        string
+	replayFixtures        string
+}
+
+func runServer(config serverConfig) {
+	serveMux, handler := newServeMux(config)
 
-	if duration, durationErr := time.ParseDuration(cacheDuration); durationErr != nil {
-		log.Fatalf("Failed to parse cache duration string, %v", durationErr)
-	} else {
-		organisations.CacheControlHeader = fmt.Sprintf("max-age=%s, public", strconv.FormatFloat(duration.Seconds(), 'f', 0, 64))
+	if config.grpcPort != "" {
+		go serveGRPC(config.grpcPort, handler)
 	}
 
+	if err := http.ListenAndServe(":"+config.port, serveMux); err != nil {
+		log.Fatalf("Unable to start server: %v", err)
+	}
+
+}
+
+// newServeMux builds the handlers of every endpoint, returning them with the organisations handler they share
+func newServeMux(config serverConfig) (*http.ServeMux, *organisations.OrganisationsHandler) {
 	servicesRouter := mux.NewRouter()
+	serveMux := http.NewServeMux()
 
-	handler := organisations.NewHandler(&httpClient, publicConceptsApiURL)
+	handler := organisations.NewHandler(newConceptsClient(config), config.publicConceptsApiURL)
+	handler.UseCachePolicy(newCachePolicy(config))
+	handler.UsePreferredParentType(config.preferredParentType)
+	handler.RedirectDeprecated(config.redirectDeprecated)
+	handler.UseGraphQLLimits(config.graphQLLimits)
+	handler.UseExportConcurrency(config.exportConcurrency)
+
+	ttl, err := time.ParseDuration(config.organisationCacheTTL)
+	if err != nil {
+		log.Fatalf("Failed to parse organisation cache ttl string, %v", err)
+	}
+	if ttl > 0 {
+		if config.organisationCacheSize < 1 {
+			log.Fatalf("Organisation cache max entries must be positive, got %d", config.organisationCacheSize)
+		}
+		cache := organisations.NewCache(ttl, config.organisationCacheSize)
+		handler.UseCache(cache)
+		if source := newChangeSource(config.invalidationSource, config.invalidationAddress); source != nil {
+			go organisations.NewInvalidator(cache).Listen(source)
+		}
+	} else if config.invalidationSource != "" {
+		log.Warn("Ignoring invalidation source as the organisation cache is disabled")
+	}
 
 	// Healthchecks and standards first
 	healthCheck := fthealth.TimedHealthCheck{
@@ -120,15 +320,110 @@ func runServer(port string, cacheDuration string, env string, publicConceptsApiU
 	// The top one of these build info endpoints feels more correct, but the lower one matches what we have in Dropwizard,
 	// so it's what apps expect currently same as ping, the content of build-info needs more definition
 	//using http router here to be able to catch "/"
-	http.HandleFunc(status.PingPath, status.PingHandler)
-	http.HandleFunc(status.PingPathDW, status.PingHandler)
-	http.HandleFunc(status.BuildInfoPath, status.BuildInfoHandler)
-	http.HandleFunc(status.BuildInfoPathDW, status.BuildInfoHandler)
+	serveMux.HandleFunc(status.PingPath, status.PingHandler)
+	serveMux.HandleFunc(status.PingPathDW, status.PingHandler)
+	serveMux.HandleFunc(status.BuildInfoPath, status.BuildInfoHandler)
+	serveMux.HandleFunc(status.BuildInfoPathDW, status.BuildInfoHandler)
 	servicesRouter.HandleFunc(status.GTGPath, status.NewGoodToGoHandler(handler.GTG))
-	http.Handle("/", monitoringRouter)
+	serveMux.Handle("/", monitoringRouter)
 
-	if err := http.ListenAndServe(":"+port, nil); err != nil {
-		log.Fatalf("Unable to start server: %v", err)
+	return serveMux, &handler
+}
+
+// serveGRPC serves the gRPC services of the handler, which share its fetcher, cache and health check
+func serveGRPC(port string, handler *organisations.OrganisationsHandler) {
+	listener, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		log.Fatalf("Unable to listen for gRPC requests: %v", err)
+	}
+	server := grpc.NewServer()
+	handler.RegisterGRPCServices(server)
+	log.Infof("public-organisations-api will serve gRPC on port: %s", port)
+	if err := server.Serve(listener); err != nil {
+		log.Fatalf("Unable to start gRPC server: %v", err)
+	}
+}
+
+// newConceptsClient returns the client requesting public-concepts-api, which can record or replay its responses
+func newConceptsClient(config serverConfig) organisations.HTTPClient {
+	timeout := defaultConceptsAPITimeout
+	if config.conceptsAPITimeout != "" {
+		var err error
+		if timeout, err = time.ParseDuration(config.conceptsAPITimeout); err != nil {
+			log.Fatalf("Failed to parse concepts API timeout, %v", err)
+		}
 	}
+	httpClient := newHTTPClient(timeout)
 
+	switch {
+	case config.replayFixtures != "":
+		log.Infof("Replaying public-concepts-api responses from %s", config.replayFixtures)
+		return organisations.NewFixtureClient(config.replayFixtures)
+	case config.recordFixtures != "":
+		client, err := organisations.NewRecordingClient(httpClient, config.recordFixtures)
+		if err != nil {
+			log.Fatalf("Failed to create fixture directory, %v", err)
+		}
+		log.Infof("Recording public-concepts-api responses into %s", config.recordFixtures)
+		return client
+	}
+	return httpClient
+}
+
+func newCachePolicy(config serverConfig) organisations.CachePolicy {
+	duration, err := time.ParseDuration(config.cacheDuration)
+	if err != nil {
+		log.Fatalf("Failed to parse cache duration string, %v", err)
+	}
+	if duration < 0 {
+		log.Fatalf("Cache duration %s is negative", config.cacheDuration)
+	}
+	policy := organisations.NewCachePolicy(duration)
+
+	overrides := []struct {
+		directives *organisations.CacheDirectives
+		value      string
+	}{
+		{&policy.OK, config.cachePolicyOK},
+		{&policy.Redirect, config.cachePolicyRedirect},
+		{&policy.NotFound, config.cachePolicyNotFound},
+		{&policy.Deprecated, config.cachePolicyDeprecated},
+	}
+	for _, override := range overrides {
+		if override.value == "" {
+			continue
+		}
+		if *override.directives, err = organisations.ParseCacheDirectives(override.value); err != nil {
+			log.Fatalf("Failed to parse cache policy, %v", err)
+		}
+	}
+	return policy
+}
+
+// newChangeSource returns the source of concept change notifications. Notifications POSTed over HTTP are served on their
+// own address rather than by the public API, so that they can be kept to local or internal callers.
+func newChangeSource(invalidationSource string, invalidationAddress string) organisations.ChangeSource {
+	switch invalidationSource {
+	case "":
+		return nil
+	case "http":
+		source := organisations.NewHTTPChangeSource()
+		router := mux.NewRouter()
+		router.Handle("/__invalidate", handlers.MethodHandler{"POST": source})
+		go func() {
+			log.Infof("public-organisations-api will accept invalidations on: %s", invalidationAddress)
+			if err := http.ListenAndServe(invalidationAddress, router); err != nil {
+				log.Fatalf("Unable to start invalidation server: %v", err)
+			}
+		}()
+		return source
+	case "-":
+		return organisations.NewReaderChangeSource(os.Stdin)
+	default:
+		f, err := os.Open(invalidationSource)
+		if err != nil {
+			log.Fatalf("Failed to open invalidation source, %v", err)
+		}
+		return organisations.NewReaderChangeSource(f)
+	}
 }
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	logger "github.com/Financial-Times/go-logger"
+	"github.com/Financial-Times/public-organisations-api/v3/conceptsapitest"
+	"github.com/Financial-Times/public-organisations-api/v3/organisations"
+	"github.com/stretchr/testify/assert"
+)
+
+const spotUUID = "100483aa-47c3-41c9-9f53-9a5aa5450fd3"
+
+func TestServerEndToEnd(t *testing.T) {
+	logger.InitLogger("test-service", "error")
+	conceptsAPI, err := conceptsapitest.NewServer("_ft/ersatz-fixtures.yml")
+	assert.NoError(t, err)
+	defer conceptsAPI.Close()
+
+	serveMux, _ := newServeMux(serverConfig{
+		cacheDuration:        "30s",
+		publicConceptsApiURL: conceptsAPI.URL,
+		conceptsAPITimeout:   "500ms",
+		organisationCacheTTL: "0s",
+	})
+	server := httptest.NewServer(serveMux)
+	defer server.Close()
+
+	type testCase struct {
+		name     string
+		path     string
+		fault    conceptsapitest.Fault
+		latency  time.Duration
+		status   int
+		contains string
+	}
+	testCases := []testCase{
+		{"Found", "/organisations/" + spotUUID, conceptsapitest.NoFault, 0, 200, `"prefLabel":"The Spot"`},
+		{"Identifier", "/organisations?authority=FACTSET&identifierValue=05HVRR-E&resolveAliases=true", conceptsapitest.NoFault, 0, 200, `"prefLabel":"The Spot"`},
+		{"IndustryClassification", "/organisations?industryClassification=38ee195d-ebdd-48a9-af4b-c8a322e7b04d", conceptsapitest.NoFault, 0, 200, `"prefLabel":"The Spot"`},
+		{"GraphQL", "/graphql?query=" + url.QueryEscape(`{ organisation(uuid: "`+spotUUID+`") { prefLabel parentOrganisation { prefLabel } } }`), conceptsapitest.NoFault, 0, 200, `{"data":{"organisation":{"parentOrganisation":{"prefLabel":"The Spot Group"},"prefLabel":"The Spot"}}}`},
+		{"NotFound", "/organisations/00000000-0000-0000-0000-000000000000", conceptsapitest.NoFault, 0, 404, "organisation not found"},
+		{"ServerError", "/organisations/" + spotUUID, conceptsapitest.FaultServerError, 0, 500, ""},
+		{"MalformedJSON", "/organisations/" + spotUUID, conceptsapitest.FaultMalformedJSON, 0, 502, "invalid organisation"},
+		{"Timeout", "/organisations/" + spotUUID, conceptsapitest.FaultTimeout, 0, 500, "failed to return organisation"},
+		{"Latency", "/organisations/" + spotUUID, conceptsapitest.NoFault, 100 * time.Millisecond, 200, `"prefLabel":"The Spot"`},
+		{"LatencyPastTimeout", "/organisations/" + spotUUID, conceptsapitest.NoFault, 5 * time.Second, 500, "failed to return organisation"},
+		{"GoodToGo", "/__gtg", conceptsapitest.NoFault, 0, 200, "OK"},
+		{"NotGoodToGo", "/__gtg", conceptsapitest.FaultUnavailable, 0, 503, ""},
+		{"GoodToGoTimeout", "/__gtg", conceptsapitest.FaultTimeout, 0, 503, ""},
+		{"Ping", "/__ping", conceptsapitest.NoFault, 0, 200, "pong"},
+	}
+
+	for _, test := range testCases {
+		conceptsAPI.InjectFault("", test.fault)
+		conceptsAPI.SetLatency(test.latency)
+		start := time.Now()
+		resp, err := http.Get(server.URL + test.path)
+		assert.NoError(t, err, test.name+" failed: the request was unsuccessful!")
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		assert.Equal(t, test.status, resp.StatusCode, test.name+" failed: status codes do not match!")
+		assert.Contains(t, string(body), test.contains, test.name+" failed: the body does not match!")
+		assert.Less(t, time.Since(start), 2*time.Second, test.name+" failed: the request was not given up in time!")
+	}
+}
+
+func TestServerExport(t *testing.T) {
+	logger.InitLogger("test-service", "error")
+	conceptsAPI, err := conceptsapitest.NewServer("_ft/ersatz-fixtures.yml")
+	assert.NoError(t, err)
+	defer conceptsAPI.Close()
+
+	serveMux, _ := newServeMux(serverConfig{
+		cacheDuration:        "30s",
+		publicConceptsApiURL: conceptsAPI.URL,
+		organisationCacheTTL: "0s",
+	})
+	server := httptest.NewServer(serveMux)
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+"/organisations/export", "text/plain", strings.NewReader(spotUUID+"\n"))
+	assert.NoError(t, err, "the request was unsuccessful!")
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "the export should succeed!")
+	lines := strings.Split(strings.TrimSuffix(string(body), "\n"), "\n")
+	assert.Len(t, lines, 1, "the export should have a line per uuid!")
+	assert.Contains(t, lines[0], `"id":"http://api.ft.com/things/`+spotUUID+`"`, "the export should hold the organisation!")
+	assert.Contains(t, lines[0], `"prefLabel":"The Spot"`, "the export should hold the organisation!")
+	assert.NotContains(t, lines[0], `"error"`, "the export should hold the organisation!")
+}
+
+func TestServerFollowsUpstreamRedirects(t *testing.T) {
+	logger.InitLogger("test-service", "error")
+	const aliasUUID = "6fc8fbac-b4ee-11e8-a790-6c96cfdf3997"
+	conceptsAPI, err := conceptsapitest.NewServer("_ft/ersatz-fixtures.yml")
+	assert.NoError(t, err)
+	defer conceptsAPI.Close()
+	conceptsAPI.SetFixture("GET", "/concepts/"+aliasUUID, conceptsapitest.Fixture{
+		Status:  http.StatusMovedPermanently,
+		Headers: map[string]string{"Location": "/concepts/" + spotUUID},
+	})
+
+	serveMux, _ := newServeMux(serverConfig{
+		cacheDuration:        "30s",
+		publicConceptsApiURL: conceptsAPI.URL,
+		organisationCacheTTL: "0s",
+	})
+	server := httptest.NewServer(serveMux)
+	defer server.Close()
+	client := http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+
+	resp, err := client.Get(server.URL + "/organisations/" + aliasUUID)
+	assert.NoError(t, err, "the request was unsuccessful!")
+	resp.Body.Close()
+	assert.Equal(t, http.StatusMovedPermanently, resp.StatusCode, "alias should redirect to the canonical organisation!")
+	assert.Equal(t, "/organisations/"+spotUUID, resp.Header.Get("Location"), "alias should redirect to the canonical organisation!")
+	assert.Equal(t, "Accept", resp.Header.Get("Vary"), "redirects should vary by Accept!")
+
+	req, _ := http.NewRequest("GET", server.URL+"/organisations/"+aliasUUID+"?resolveAliases=true", nil)
+	req.Header.Set("Accept", "application/vnd.ft.organisation.v2+json")
+	resp, err = client.Do(req)
+	assert.NoError(t, err, "the request was unsuccessful!")
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "resolved alias should return the canonical organisation!")
+	assert.Equal(t, "/organisations/"+spotUUID, resp.Header.Get("Content-Location"), "resolved alias should locate the canonical organisation!")
+	assert.Contains(t, string(body), aliasUUID, "the redirected alias should be listed in the aliases!")
+}
+
+func TestRecordingUpstreamRedirects(t *testing.T) {
+	logger.InitLogger("test-service", "error")
+	const aliasUUID = "6fc8fbac-b4ee-11e8-a790-6c96cfdf3997"
+	conceptsAPI, err := conceptsapitest.NewServer("_ft/ersatz-fixtures.yml")
+	assert.NoError(t, err)
+	defer conceptsAPI.Close()
+	conceptsAPI.SetFixture("GET", "/concepts/"+aliasUUID, conceptsapitest.Fixture{
+		Status:  http.StatusMovedPermanently,
+		Headers: map[string]string{"Location": "/concepts/" + spotUUID},
+	})
+
+	dir := t.TempDir()
+	config := serverConfig{publicConceptsApiURL: conceptsAPI.URL, recordFixtures: dir}
+	handler := organisations.NewHandler(newConceptsClient(config), conceptsAPI.URL)
+	_, found, err := handler.FetchOrganisation(aliasUUID, "tid_test")
+	assert.NoError(t, err)
+	assert.True(t, found)
+
+	location, err := ioutil.ReadFile(filepath.Join(dir, aliasUUID+".redirect"))
+	assert.NoError(t, err, "the redirect of the alias was not recorded!")
+	assert.Equal(t, "/concepts/"+spotUUID+"\n", string(location))
+	_, err = os.Stat(filepath.Join(dir, aliasUUID+".json"))
+	assert.True(t, os.IsNotExist(err), "the canonical concept was recorded as the alias!")
+	_, err = os.Stat(filepath.Join(dir, spotUUID+".json"))
+	assert.NoError(t, err, "the canonical concept was not recorded!")
+}
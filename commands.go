@@ -0,0 +1,401 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Financial-Times/public-organisations-api/v3/organisations"
+	transactionidutils "github.com/Financial-Times/transactionid-utils-go"
+	cli "github.com/jawher/mow.cli"
+)
+
+// lookupConfig is what the lookup subcommands share with the server, read once the options are parsed
+type lookupConfig struct {
+	publicConceptsApiURL *string
+	preferredParentType  *string
+}
+
+// streams are where the subcommands read UUIDs from and print to
+type streams struct {
+	in  io.Reader
+	out io.Writer
+	err io.Writer
+}
+
+var stdStreams = streams{in: os.Stdin, out: os.Stdout, err: os.Stderr}
+
+// registerLookupCommands adds the get and batch subcommands, which print mapped organisations without running the server
+func registerLookupCommands(app *cli.Cli, config lookupConfig) {
+	app.Command("get", "Print the organisation with the given UUID, mapped as the API would return it", func(cmd *cli.Cmd) {
+		cmd.Spec = "[--fixtures] [--output] [--columns] UUID"
+		options := lookupOptions(cmd)
+		uuid := cmd.StringArg("UUID", "", "UUID of the organisation")
+
+		cmd.Action = func() {
+			exit(lookup(config, options, []string{*uuid}, false, stdStreams))
+		}
+	})
+
+	app.Command("batch", "Print the organisations with the UUIDs listed one per line in a file, mapped as the API would return them", func(cmd *cli.Cmd) {
+		cmd.Spec = "[--fixtures] [--output] [--columns] FILE"
+		options := lookupOptions(cmd)
+		file := cmd.StringArg("FILE", "", "File listing the UUIDs, or - for stdin")
+
+		cmd.Action = func() {
+			exit(batch(config, options, *file, stdStreams))
+		}
+	})
+
+	app.Command("diff", "Compare the organisations with the UUIDs listed in a file as mapped from two public-concepts-api URLs or fixture directories", func(cmd *cli.Cmd) {
+		cmd.Spec = "[--output] FILE LEFT RIGHT"
+		output := cmd.String(cli.StringOpt{
+			Name:  "output",
+			Value: "json",
+			Desc:  "Output format: json for a machine-readable report, or text",
+		})
+		file := cmd.StringArg("FILE", "", "File listing the UUIDs, or - for stdin")
+		left := cmd.StringArg("LEFT", "", "Base URL of the public-concepts-api, or fixture directory, compared from")
+		right := cmd.StringArg("RIGHT", "", "Base URL of the public-concepts-api, or fixture directory, compared to")
+
+		cmd.Action = func() {
+			exit(diff(config, *output, *file, *left, *right, stdStreams))
+		}
+	})
+}
+
+// lookupFlags are the options of the lookup subcommands
+type lookupFlags struct {
+	fixtures *string
+	output   *string
+	columns  *string
+}
+
+func lookupOptions(cmd *cli.Cmd) lookupFlags {
+	return lookupFlags{
+		fixtures: cmd.String(cli.StringOpt{
+			Name: "fixtures",
+			Desc: "Directory of <uuid>.json public-concepts-api responses to read concepts from instead of --publicConceptsApiURL",
+		}),
+		output: cmd.String(cli.StringOpt{
+			Name:  "output",
+			Value: "json",
+			Desc:  "Output format: json, csv or table",
+		}),
+		columns: cmd.String(cli.StringOpt{
+			Name:  "columns",
+			Value: strings.Join(organisations.SummaryColumns(), ","),
+			Desc:  "Comma separated columns of the csv and table outputs",
+		}),
+	}
+}
+
+// exit exits with the code unless it is 0, when the command returns as usual
+func exit(code int) {
+	if code != 0 {
+		cli.Exit(code)
+	}
+}
+
+// batch looks up the organisations listed in the file, or in the input stream for -
+func batch(config lookupConfig, options lookupFlags, file string, std streams) int {
+	uuids, err := readUUIDs(file, std.in)
+	if err != nil {
+		fmt.Fprintf(std.err, "Failed to read uuids: %v\n", err)
+		return 1
+	}
+	return lookup(config, options, uuids, true, std)
+}
+
+// lookup prints the organisations, reporting those that are not found or fail to map on the error stream.
+// It returns the exit code: 1 if any are, or if printing fails, and 2 for an unknown output format or column.
+func lookup(config lookupConfig, options lookupFlags, uuids []string, batch bool, std streams) int {
+	columns := []string{}
+	for _, column := range strings.Split(*options.columns, ",") {
+		columns = append(columns, strings.TrimSpace(column))
+	}
+	printer, err := newOrganisationPrinter(std.out, *options.output, columns, !batch)
+	if err != nil {
+		fmt.Fprintln(std.err, err)
+		return 2
+	}
+
+	handler := newLookupHandler(config, *config.publicConceptsApiURL, *options.fixtures)
+
+	transID := transactionidutils.NewTransactionID()
+	failed := 0
+	for _, uuid := range uuids {
+		organisation, found, err := handler.FetchOrganisation(uuid, transID)
+		switch {
+		case err != nil:
+			fmt.Fprintf(std.err, "Failed to return organisation %s: %v\n", uuid, err)
+			failed++
+		case !found:
+			fmt.Fprintf(std.err, "Organisation %s not found\n", uuid)
+			failed++
+		default:
+			if err := printer.print(organisation); err != nil {
+				fmt.Fprintf(std.err, "Failed to print organisation %s: %v\n", uuid, err)
+				return 1
+			}
+		}
+	}
+	if err := printer.flush(); err != nil {
+		fmt.Fprintf(std.err, "Failed to print organisations: %v\n", err)
+		return 1
+	}
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}
+
+// newLookupHandler maps organisations from public-concepts-api at the URL, or from the fixture directory if there is one
+func newLookupHandler(config lookupConfig, conceptsURL string, fixtures string) organisations.OrganisationsHandler {
+	var client organisations.HTTPClient = newHTTPClient(defaultConceptsAPITimeout)
+	if fixtures != "" {
+		client = organisations.NewFixtureClient(fixtures)
+	}
+	handler := organisations.NewHandler(client, conceptsURL)
+	handler.UsePreferredParentType(*config.preferredParentType)
+	return handler
+}
+
+// readUUIDs reads the non-blank lines of the file, or of stdin for -
+func readUUIDs(file string, stdin io.Reader) ([]string, error) {
+	r := stdin
+	if file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+	uuids := []string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if uuid := strings.TrimSpace(scanner.Text()); uuid != "" {
+			uuids = append(uuids, uuid)
+		}
+	}
+	return uuids, scanner.Err()
+}
+
+// organisationPrinter prints organisations in an output format
+type organisationPrinter interface {
+	print(organisation organisations.Organisation) error
+	flush() error
+}
+
+// newOrganisationPrinter returns the printer of the format; json is indented for a single organisation, and one per line otherwise
+func newOrganisationPrinter(w io.Writer, format string, columns []string, single bool) (organisationPrinter, error) {
+	if _, err := organisations.Summarise(organisations.Organisation{}, columns); err != nil {
+		return nil, err
+	}
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(w)
+		if single {
+			encoder.SetIndent("", "  ")
+		}
+		return jsonPrinter{encoder: encoder}, nil
+	case "csv":
+		writer := csv.NewWriter(w)
+		return &summaryPrinter{columns: columns, write: writer.Write, done: func() error {
+			writer.Flush()
+			return writer.Error()
+		}}, nil
+	case "table":
+		writer := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		return &summaryPrinter{columns: columns, write: func(record []string) error {
+			_, err := fmt.Fprintln(writer, strings.Join(record, "\t"))
+			return err
+		}, done: writer.Flush}, nil
+	}
+	return nil, fmt.Errorf("output '%s' is not one of json, csv or table", format)
+}
+
+type jsonPrinter struct {
+	encoder *json.Encoder
+}
+
+func (p jsonPrinter) print(organisation organisations.Organisation) error {
+	return p.encoder.Encode(organisation)
+}
+
+func (p jsonPrinter) flush() error {
+	return nil
+}
+
+// summaryPrinter prints a header and a record of the summary columns per organisation
+type summaryPrinter struct {
+	columns       []string
+	write         func(record []string) error
+	done          func() error
+	headerWritten bool
+}
+
+func (p *summaryPrinter) print(organisation organisations.Organisation) error {
+	if err := p.header(); err != nil {
+		return err
+	}
+	record, err := organisations.Summarise(organisation, p.columns)
+	if err != nil {
+		return err
+	}
+	return p.write(record)
+}
+
+func (p *summaryPrinter) flush() error {
+	if err := p.header(); err != nil {
+		return err
+	}
+	return p.done()
+}
+
+func (p *summaryPrinter) header() error {
+	if p.headerWritten {
+		return nil
+	}
+	p.headerWritten = true
+	return p.write(p.columns)
+}
+
+// Statuses of organisations in a diff report
+const (
+	diffUnchanged = "unchanged"
+	diffChanged   = "changed"
+	diffOnlyLeft  = "onlyLeft"
+	diffOnlyRight = "onlyRight"
+	diffNotFound  = "notFound"
+	diffFailed    = "failed"
+)
+
+// diffReport summarises how the organisations differ between two sources. Organisations found on one side only are
+// counted as changed, and those found on neither side as unchanged.
+type diffReport struct {
+	Compared      int                `json:"compared"`
+	Changed       int                `json:"changed"`
+	Unchanged     int                `json:"unchanged"`
+	Failed        int                `json:"failed"`
+	Organisations []organisationDiff `json:"organisations"`
+}
+
+// organisationDiff is the status of one organisation in a diff report, with the differences of changed organisations
+type organisationDiff struct {
+	UUID        string                     `json:"uuid"`
+	Status      string                     `json:"status"`
+	Differences []organisations.Difference `json:"differences,omitempty"`
+	Error       string                     `json:"error,omitempty"`
+}
+
+// diff prints the report comparing the organisations listed in the file, or in the input stream for -, between the
+// sources. It returns the exit code: 1 if any organisation changed or failed, or if reading or printing fails, and 2 for
+// an unknown output format.
+func diff(config lookupConfig, output string, file string, left string, right string, std streams) int {
+	if output != "json" && output != "text" {
+		fmt.Fprintf(std.err, "output '%s' is not one of json or text\n", output)
+		return 2
+	}
+	uuids, err := readUUIDs(file, std.in)
+	if err != nil {
+		fmt.Fprintf(std.err, "Failed to read uuids: %v\n", err)
+		return 1
+	}
+	report := diffOrganisations(newDiffSource(config, left), newDiffSource(config, right), uuids)
+	if output == "json" {
+		err = json.NewEncoder(std.out).Encode(report)
+	} else {
+		err = report.writeText(std.out)
+	}
+	if err != nil {
+		fmt.Fprintf(std.err, "Failed to print report: %v\n", err)
+		return 1
+	}
+	if report.Changed > 0 || report.Failed > 0 {
+		return 1
+	}
+	return 0
+}
+
+// newDiffSource maps organisations from the URL if the source is one, or from the fixture directory otherwise
+func newDiffSource(config lookupConfig, source string) organisations.OrganisationsHandler {
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		return newLookupHandler(config, strings.TrimSuffix(source, "/"), "")
+	}
+	return newLookupHandler(config, "", source)
+}
+
+func diffOrganisations(left organisations.OrganisationsHandler, right organisations.OrganisationsHandler, uuids []string) diffReport {
+	report := diffReport{Organisations: []organisationDiff{}}
+	transID := transactionidutils.NewTransactionID()
+	for _, uuid := range uuids {
+		result := diffOrganisation(&left, &right, uuid, transID)
+		switch result.Status {
+		case diffFailed:
+			report.Failed++
+		case diffUnchanged, diffNotFound:
+			report.Unchanged++
+		default:
+			report.Changed++
+		}
+		report.Compared++
+		report.Organisations = append(report.Organisations, result)
+	}
+	return report
+}
+
+func diffOrganisation(left *organisations.OrganisationsHandler, right *organisations.OrganisationsHandler, uuid string, transID string) organisationDiff {
+	leftOrganisation, leftFound, err := left.FetchOrganisation(uuid, transID)
+	if err != nil {
+		return organisationDiff{UUID: uuid, Status: diffFailed, Error: fmt.Sprintf("left: %v", err)}
+	}
+	rightOrganisation, rightFound, err := right.FetchOrganisation(uuid, transID)
+	if err != nil {
+		return organisationDiff{UUID: uuid, Status: diffFailed, Error: fmt.Sprintf("right: %v", err)}
+	}
+	switch {
+	case !leftFound && !rightFound:
+		return organisationDiff{UUID: uuid, Status: diffNotFound}
+	case !rightFound:
+		return organisationDiff{UUID: uuid, Status: diffOnlyLeft}
+	case !leftFound:
+		return organisationDiff{UUID: uuid, Status: diffOnlyRight}
+	}
+
+	differences, err := organisations.Diff(leftOrganisation, rightOrganisation)
+	if err != nil {
+		return organisationDiff{UUID: uuid, Status: diffFailed, Error: err.Error()}
+	}
+	if len(differences) == 0 {
+		return organisationDiff{UUID: uuid, Status: diffUnchanged}
+	}
+	return organisationDiff{UUID: uuid, Status: diffChanged, Differences: differences}
+}
+
+// writeText writes the organisations that are not unchanged, with their differences, then the totals
+func (r diffReport) writeText(w io.Writer) error {
+	for _, organisation := range r.Organisations {
+		switch organisation.Status {
+		case diffUnchanged, diffNotFound:
+			continue
+		case diffFailed:
+			fmt.Fprintf(w, "%s failed: %s\n", organisation.UUID, organisation.Error)
+		default:
+			fmt.Fprintf(w, "%s %s\n", organisation.UUID, organisation.Status)
+		}
+		for _, difference := range organisation.Differences {
+			left, _ := json.Marshal(difference.Left)
+			right, _ := json.Marshal(difference.Right)
+			fmt.Fprintf(w, "  %s: %s -> %s\n", difference.Field, left, right)
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d compared, %d changed, %d unchanged, %d failed\n", r.Compared, r.Changed, r.Unchanged, r.Failed)
+	return err
+}
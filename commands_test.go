@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	logger "github.com/Financial-Times/go-logger"
+	"github.com/Financial-Times/public-organisations-api/v3/conceptsapitest"
+	"github.com/stretchr/testify/assert"
+)
+
+const spotID = "http://api.ft.com/things/" + spotUUID
+
+func TestLookupCommands(t *testing.T) {
+	logger.InitLogger("test-service", "error")
+	conceptsAPI, err := conceptsapitest.NewServer("_ft/ersatz-fixtures.yml")
+	assert.NoError(t, err)
+	defer conceptsAPI.Close()
+
+	conceptsURL := conceptsAPI.URL
+	preferredParentType := ""
+	config := lookupConfig{publicConceptsApiURL: &conceptsURL, preferredParentType: &preferredParentType}
+
+	tableHeader := "id" + strings.Repeat(" ", len(spotID)) + "prefLabel\n"
+
+	type testCase struct {
+		name    string
+		batch   bool
+		input   string
+		output  string
+		columns string
+		fault   conceptsapitest.Fault
+		code    int
+		stdout  string
+		stderr  string
+	}
+	testCases := []testCase{
+		{"GetJSON", false, spotUUID, "json", "id,prefLabel", conceptsapitest.NoFault, 0, "{\n  \"id\": \"" + spotID + "\",", ""},
+		{"GetCSV", false, spotUUID, "csv", "id,prefLabel", conceptsapitest.NoFault, 0, "id,prefLabel\n" + spotID + ",The Spot\n", ""},
+		{"GetTable", false, spotUUID, "table", "id,prefLabel", conceptsapitest.NoFault, 0, tableHeader + spotID + "  The Spot\n", ""},
+		{"GetNotFound", false, "00000000-0000-0000-0000-000000000000", "json", "id,prefLabel", conceptsapitest.NoFault, 1, "", "Organisation 00000000-0000-0000-0000-000000000000 not found\n"},
+		{"GetFailure", false, spotUUID, "json", "id,prefLabel", conceptsapitest.FaultServerError, 1, "", "Failed to return organisation " + spotUUID},
+		{"UnknownOutput", false, spotUUID, "xml", "id,prefLabel", conceptsapitest.NoFault, 2, "", "output 'xml' is not one of json, csv or table\n"},
+		{"UnknownColumn", false, spotUUID, "csv", "id,unknown", conceptsapitest.NoFault, 2, "", "unknown"},
+		{"BatchJSON", true, spotUUID + "\n\n" + spotUUID + "\n", "json", "id,prefLabel", conceptsapitest.NoFault, 0, "{\"id\":\"" + spotID + "\",", ""},
+		{"BatchCSV", true, spotUUID + "\n" + spotUUID, "csv", "id,prefLabel", conceptsapitest.NoFault, 0, "id,prefLabel\n" + spotID + ",The Spot\n" + spotID + ",The Spot\n", ""},
+		{"BatchTable", true, spotUUID, "table", "id,prefLabel", conceptsapitest.NoFault, 0, tableHeader + spotID + "  The Spot\n", ""},
+		{"BatchPartialFailure", true, "00000000-0000-0000-0000-000000000000\n" + spotUUID, "csv", "id,prefLabel", conceptsapitest.NoFault, 1, "id,prefLabel\n" + spotID + ",The Spot\n", "Organisation 00000000-0000-0000-0000-000000000000 not found\n"},
+		{"BatchEmpty", true, "\n", "csv", "id,prefLabel", conceptsapitest.NoFault, 0, "id,prefLabel\n", ""},
+	}
+
+	for _, test := range testCases {
+		conceptsAPI.InjectFault("", test.fault)
+		fixtures := ""
+		options := lookupFlags{fixtures: &fixtures, output: &test.output, columns: &test.columns}
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+		std := streams{in: strings.NewReader(test.input), out: stdout, err: stderr}
+
+		var code int
+		if test.batch {
+			code = batch(config, options, "-", std)
+		} else {
+			code = lookup(config, options, []string{test.input}, false, std)
+		}
+
+		assert.Equal(t, test.code, code, test.name+" failed: exit codes do not match!")
+		if test.stdout == "" {
+			assert.Empty(t, stdout.String(), test.name+" failed: the output does not match!")
+		} else {
+			assert.True(t, strings.HasPrefix(stdout.String(), test.stdout), test.name+" failed: the output does not match!\n"+stdout.String())
+		}
+		if test.stderr == "" {
+			assert.Empty(t, stderr.String(), test.name+" failed: the errors do not match!")
+		} else {
+			assert.Contains(t, stderr.String(), test.stderr, test.name+" failed: the errors do not match!")
+		}
+	}
+}
+
+func TestReadUUIDs(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "uuids.txt")
+	assert.NoError(t, os.WriteFile(file, []byte(" "+spotUUID+" \n\n1234\n"), 0600))
+
+	type testCase struct {
+		name  string
+		file  string
+		stdin string
+		uuids []string
+		err   bool
+	}
+	testCases := []testCase{
+		{"Stdin", "-", spotUUID + "\r\n\n1234", []string{spotUUID, "1234"}, false},
+		{"File", file, spotUUID, []string{spotUUID, "1234"}, false},
+		{"Empty", "-", "", []string{}, false},
+		{"MissingFile", filepath.Join(t.TempDir(), "missing.txt"), "", nil, true},
+	}
+
+	for _, test := range testCases {
+		uuids, err := readUUIDs(test.file, strings.NewReader(test.stdin))
+		assert.Equal(t, test.err, err != nil, test.name+" failed: errors do not match!")
+		assert.Equal(t, test.uuids, uuids, test.name+" failed: uuids do not match!")
+	}
+}
+
+func TestDiffCommand(t *testing.T) {
+	logger.InitLogger("test-service", "error")
+	const (
+		subsidiaryUUID  = "6a8a8c36-7e3d-4d5b-9f0a-2c6f2b2b1a01"
+		subsidiary2UUID = "6a8a8c36-7e3d-4d5b-9f0a-2c6f2b2b1a02"
+		missingUUID     = "00000000-0000-0000-0000-000000000000"
+	)
+	conceptsURL := ""
+	preferredParentType := ""
+	config := lookupConfig{publicConceptsApiURL: &conceptsURL, preferredParentType: &preferredParentType}
+
+	spot := concept(spotUUID, "The Spot", subsidiaryUUID, subsidiary2UUID)
+	type testCase struct {
+		name     string
+		left     map[string]string
+		right    map[string]string
+		uuids    string
+		output   string
+		code     int
+		statuses []string
+		contains string
+	}
+	testCases := []testCase{
+		{"Unchanged", map[string]string{spotUUID: spot}, map[string]string{spotUUID: spot}, spotUUID, "json", 0, []string{diffUnchanged}, `"compared":1,"changed":0,"unchanged":1,"failed":0`},
+		{"Reordered", map[string]string{spotUUID: spot}, map[string]string{spotUUID: concept(spotUUID, "The Spot", subsidiary2UUID, subsidiaryUUID)}, spotUUID, "json", 0, []string{diffUnchanged}, `"compared":1,"changed":0,"unchanged":1,"failed":0`},
+		{"SubsidiaryRemoved", map[string]string{spotUUID: spot}, map[string]string{spotUUID: concept(spotUUID, "The Spot", subsidiary2UUID)}, spotUUID, "text", 1, nil,
+			spotUUID + " changed\n  subsidiaries[id=http://api.ft.com/things/" + subsidiaryUUID + "]: "},
+		{"Changed", map[string]string{spotUUID: spot}, map[string]string{spotUUID: concept(spotUUID, "The Spot Ltd", subsidiaryUUID, subsidiary2UUID)}, spotUUID, "text", 1, nil,
+			"  prefLabel: \"The Spot\" -> \"The Spot Ltd\"\n1 compared, 1 changed, 0 unchanged, 0 failed\n"},
+		{"OnEitherSide", map[string]string{spotUUID: spot}, map[string]string{subsidiaryUUID: concept(subsidiaryUUID, "The Spot France")}, spotUUID + "\n" + subsidiaryUUID + "\n" + missingUUID, "json", 1,
+			[]string{diffOnlyLeft, diffOnlyRight, diffNotFound}, `"compared":3,"changed":2,"unchanged":1,"failed":0`},
+		{"Failed", map[string]string{spotUUID: spot}, map[string]string{spotUUID: "{"}, spotUUID, "json", 1, []string{diffFailed}, `"compared":1,"changed":0,"unchanged":0,"failed":1`},
+		{"UnknownOutput", map[string]string{}, map[string]string{}, spotUUID, "xml", 2, nil, ""},
+	}
+
+	for _, test := range testCases {
+		left, right := writeFixtures(t, test.left), writeFixtures(t, test.right)
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+		std := streams{in: strings.NewReader(test.uuids), out: stdout, err: stderr}
+
+		code := diff(config, test.output, "-", left, right, std)
+
+		assert.Equal(t, test.code, code, test.name+" failed: exit codes do not match!")
+		assert.Contains(t, stdout.String(), test.contains, test.name+" failed: the report does not match!")
+		if test.statuses != nil {
+			report := diffReport{}
+			assert.NoError(t, json.Unmarshal(stdout.Bytes(), &report), test.name+" failed: the report is not JSON!")
+			statuses := []string{}
+			for _, organisation := range report.Organisations {
+				statuses = append(statuses, organisation.Status)
+			}
+			assert.Equal(t, test.statuses, statuses, test.name+" failed: statuses do not match!")
+		}
+	}
+}
+
+// concept is the public-concepts-api body of an organisation with the subsidiaries, in order
+func concept(uuid string, prefLabel string, subsidiaries ...string) string {
+	related := []string{}
+	for _, subsidiary := range subsidiaries {
+		related = append(related, `{"concept":{"id":"http://www.ft.com/thing/`+subsidiary+`","apiUrl":"http://api.ft.com/concepts/`+subsidiary+`","type":"http://www.ft.com/ontology/organisation/Organisation"},"predicate":"http://www.ft.com/ontology/parentOrganisationOf"}`)
+	}
+	return `{"id":"http://www.ft.com/thing/` + uuid + `","apiUrl":"http://api.ft.com/concepts/` + uuid + `","type":"http://www.ft.com/ontology/organisation/Organisation","prefLabel":"` + prefLabel + `","relatedConcepts":[` + strings.Join(related, ",") + `]}`
+}
+
+// writeFixtures writes the concept bodies by uuid into a new fixture directory
+func writeFixtures(t *testing.T, concepts map[string]string) string {
+	dir := t.TempDir()
+	for uuid, body := range concepts {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, uuid+".json"), []byte(body), 0600))
+	}
+	return dir
+}
@@ -0,0 +1,298 @@
+// Package conceptsapitest provides a fake public-concepts-api for tests, serving the fixtures that dredd serves with ersatz.
+package conceptsapitest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Fault is a failure injected into the responses of the fake
+type Fault int
+
+const (
+	// NoFault serves the fixtures
+	NoFault Fault = iota
+	// FaultTimeout never responds, until the request is cancelled or the server is closed
+	FaultTimeout
+	// FaultServerError responds with a 500
+	FaultServerError
+	// FaultUnavailable responds with a 503
+	FaultUnavailable
+	// FaultMalformedJSON responds with a 200 and a body that is not valid JSON
+	FaultMalformedJSON
+)
+
+// Fixtures are ersatz fixtures, such as those of _ft/ersatz-fixtures.yml: responses by path, then by lower case method
+type Fixtures struct {
+	Version  string                          `yaml:"version"`
+	Fixtures map[string]map[string]Responses `yaml:"fixtures"`
+}
+
+// Fixture is the response to a request. A fixture with query parameters only answers requests with those parameters.
+type Fixture struct {
+	QueryParameters map[string]string `yaml:"queryParameters"`
+	Status          int               `yaml:"status"`
+	Produces        []string          `yaml:"produces"`
+	Headers         map[string]string `yaml:"headers"`
+	Body            interface{}       `yaml:"body"`
+}
+
+// Responses are the fixtures of a path and method, either a list of fixtures as in ersatz 2 or a single fixture as in ersatz 1
+type Responses []Fixture
+
+func (r *Responses) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.SequenceNode {
+		fixture := Fixture{}
+		if err := value.Decode(&fixture); err != nil {
+			return err
+		}
+		*r = Responses{fixture}
+		return nil
+	}
+	fixtures := []Fixture{}
+	if err := value.Decode(&fixtures); err != nil {
+		return err
+	}
+	*r = fixtures
+	return nil
+}
+
+// match returns the fixture with the most query parameters that are all in the query
+func (r Responses) match(query url.Values) (Fixture, bool) {
+	matched, found := Fixture{}, false
+	for _, fixture := range r {
+		if found && len(fixture.QueryParameters) <= len(matched.QueryParameters) {
+			continue
+		}
+		matches := true
+		for name, value := range fixture.QueryParameters {
+			if query.Get(name) != value {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			matched, found = fixture, true
+		}
+	}
+	return matched, found
+}
+
+// Server is a fake public-concepts-api. Requests for a path without a fixture are not found. The relationships of
+// concepts are filtered by the showRelationship parameters as public-concepts-api does: relatedConcepts are only returned
+// with showRelationship=related, or for the predicates named, and broaderConcepts and narrowerConcepts with broader and narrower.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	fixtures Fixtures
+	latency  time.Duration
+	faults   map[string]Fault
+	closed   chan struct{}
+	once     sync.Once
+}
+
+// LoadFixtures reads ersatz fixtures from a YAML file
+func LoadFixtures(path string) (Fixtures, error) {
+	fixtures := Fixtures{}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fixtures, err
+	}
+	if err := yaml.Unmarshal(content, &fixtures); err != nil {
+		return fixtures, fmt.Errorf("failed to parse fixtures %s: %v", path, err)
+	}
+	return fixtures, nil
+}
+
+// NewServer starts a fake serving the fixtures of the YAML file. It should be closed when done.
+func NewServer(path string) (*Server, error) {
+	fixtures, err := LoadFixtures(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewServerWithFixtures(fixtures), nil
+}
+
+// NewServerWithFixtures starts a fake serving the fixtures. It should be closed when done.
+func NewServerWithFixtures(fixtures Fixtures) *Server {
+	s := &Server{fixtures: fixtures, faults: map[string]Fault{}, closed: make(chan struct{})}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Close unblocks requests hanging on an injected timeout, then shuts the server down
+func (s *Server) Close() {
+	s.once.Do(func() { close(s.closed) })
+	s.Server.Close()
+}
+
+// SetLatency delays every response by the duration
+func (s *Server) SetLatency(latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = latency
+}
+
+// InjectFault makes requests for the path fail; an empty path makes every request fail. NoFault removes the fault.
+func (s *Server) InjectFault(path string, fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if fault == NoFault {
+		delete(s.faults, path)
+		return
+	}
+	s.faults[path] = fault
+}
+
+// SetFixture adds the fixture of the method and path, or replaces the one with the same query parameters
+func (s *Server) SetFixture(method string, path string, fixture Fixture) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fixtures.Fixtures == nil {
+		s.fixtures.Fixtures = map[string]map[string]Responses{}
+	}
+	if s.fixtures.Fixtures[path] == nil {
+		s.fixtures.Fixtures[path] = map[string]Responses{}
+	}
+	method = strings.ToLower(method)
+	responses := Responses{}
+	for _, existing := range s.fixtures.Fixtures[path][method] {
+		if !sameParameters(existing.QueryParameters, fixture.QueryParameters) {
+			responses = append(responses, existing)
+		}
+	}
+	s.fixtures.Fixtures[path][method] = append(responses, fixture)
+}
+
+func sameParameters(a map[string]string, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, value := range a {
+		if other, found := b[name]; !found || other != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	latency := s.latency
+	fault, faulty := s.faults[r.URL.Path]
+	if !faulty {
+		fault = s.faults[""]
+	}
+	fixture, found := s.fixtures.Fixtures[r.URL.Path][strings.ToLower(r.Method)].match(r.URL.Query())
+	s.mu.Unlock()
+
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return
+		case <-s.closed:
+			return
+		}
+	}
+
+	switch fault {
+	case FaultTimeout:
+		select {
+		case <-r.Context().Done():
+		case <-s.closed:
+		}
+		return
+	case FaultServerError:
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	case FaultUnavailable:
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	case FaultMalformedJSON:
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "http://www.ft.com/thing/`))
+		return
+	}
+
+	if !found {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "Concept not found"}`))
+		return
+	}
+	s.write(w, r, fixture)
+}
+
+func (s *Server) write(w http.ResponseWriter, r *http.Request, fixture Fixture) {
+	for name, value := range fixture.Headers {
+		w.Header().Set(name, value)
+	}
+	status := fixture.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	if fixture.Body == nil {
+		w.WriteHeader(status)
+		return
+	}
+
+	body := fixture.Body
+	if concept, ok := body.(map[string]interface{}); ok && strings.HasPrefix(r.URL.Path, "/concepts/") {
+		body = showRelationships(concept, r.URL.Query()["showRelationship"])
+	}
+	content, err := json.Marshal(body)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("fixture of %s cannot be written as JSON: %v", r.URL.Path, err), http.StatusInternalServerError)
+		return
+	}
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.WriteHeader(status)
+	w.Write(content)
+}
+
+// showRelationships returns a copy of the concept with only the relationships that are shown
+func showRelationships(concept map[string]interface{}, shown []string) map[string]interface{} {
+	show := map[string]bool{}
+	for _, relationship := range shown {
+		show[relationship] = true
+	}
+
+	filtered := map[string]interface{}{}
+	for key, value := range concept {
+		filtered[key] = value
+	}
+	if !show["broader"] {
+		delete(filtered, "broaderConcepts")
+	}
+	if !show["narrower"] {
+		delete(filtered, "narrowerConcepts")
+	}
+	if related, ok := concept["relatedConcepts"].([]interface{}); ok && !show["related"] {
+		kept := []interface{}{}
+		for _, relation := range related {
+			fields, _ := relation.(map[string]interface{})
+			if predicate, ok := fields["predicate"].(string); ok && show[predicate[strings.LastIndex(predicate, "/")+1:]] {
+				kept = append(kept, relation)
+			}
+		}
+		if len(kept) == 0 {
+			delete(filtered, "relatedConcepts")
+		} else {
+			filtered["relatedConcepts"] = kept
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,149 @@
+package conceptsapitest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const spotUUID = "100483aa-47c3-41c9-9f53-9a5aa5450fd3"
+
+func TestServerServesErsatzFixtures(t *testing.T) {
+	server, err := NewServer("../_ft/ersatz-fixtures.yml")
+	assert.NoError(t, err)
+	defer server.Close()
+
+	type testCase struct {
+		name        string
+		path        string
+		status      int
+		contains    string
+		notContains string
+	}
+	testCases := []testCase{
+		{"Concept", "/concepts/" + spotUUID + "?showRelationship=related", 200, `"prefLabel":"The Spot"`, ""},
+		{"Identifier", "/concepts?authority=FACTSET&identifierValue=05HVRR-E", 200, `"prefLabel":"The Spot"`, ""},
+		{"UnknownIdentifier", "/concepts?authority=FACTSET&identifierValue=000C7F-E", 404, "Concept not found", ""},
+		{"GoodToGo", "/__gtg", 200, "", ""},
+		{"NotFound", "/concepts/00000000-0000-0000-0000-000000000000", 404, "Concept not found", ""},
+	}
+
+	for _, test := range testCases {
+		resp, err := http.Get(server.URL + test.path)
+		assert.NoError(t, err, test.name+" failed: the request was unsuccessful!")
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		assert.Equal(t, test.status, resp.StatusCode, test.name+" failed: status codes do not match!")
+		assert.Contains(t, string(body), test.contains, test.name+" failed: the body does not match!")
+	}
+}
+
+func TestServerShowsRelationships(t *testing.T) {
+	server := NewServerWithFixtures(Fixtures{})
+	defer server.Close()
+	server.SetFixture("GET", "/concepts/"+spotUUID, Fixture{Status: 200, Body: map[string]interface{}{
+		"id": "http://www.ft.com/thing/" + spotUUID,
+		"relatedConcepts": []interface{}{
+			map[string]interface{}{"predicate": "http://www.ft.com/ontology/subOrganisationOf"},
+			map[string]interface{}{"predicate": "http://www.ft.com/ontology/hasBrand"},
+		},
+		"broaderConcepts": []interface{}{map[string]interface{}{"predicate": "http://www.w3.org/2004/02/skos/core#broader"}},
+	}})
+
+	type testCase struct {
+		name     string
+		query    string
+		expected string
+	}
+	testCases := []testCase{
+		{"None", "", `{"id":"http://www.ft.com/thing/` + spotUUID + `"}`},
+		{"Related", "?showRelationship=related", `{"id":"http://www.ft.com/thing/` + spotUUID + `","relatedConcepts":[{"predicate":"http://www.ft.com/ontology/subOrganisationOf"},{"predicate":"http://www.ft.com/ontology/hasBrand"}]}`},
+		{"Predicate", "?showRelationship=hasBrand", `{"id":"http://www.ft.com/thing/` + spotUUID + `","relatedConcepts":[{"predicate":"http://www.ft.com/ontology/hasBrand"}]}`},
+		{"Broader", "?showRelationship=broader", `{"broaderConcepts":[{"predicate":"http://www.w3.org/2004/02/skos/core#broader"}],"id":"http://www.ft.com/thing/` + spotUUID + `"}`},
+	}
+
+	for _, test := range testCases {
+		resp, err := http.Get(server.URL + "/concepts/" + spotUUID + test.query)
+		assert.NoError(t, err, test.name+" failed: the request was unsuccessful!")
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		assert.Equal(t, test.expected, string(body), test.name+" failed: bodies do not match!")
+	}
+}
+
+func TestServerMatchesQueryParameters(t *testing.T) {
+	server := NewServerWithFixtures(Fixtures{})
+	defer server.Close()
+	server.SetFixture("GET", "/concepts", Fixture{Status: 200, Body: "any"})
+	server.SetFixture("GET", "/concepts", Fixture{QueryParameters: map[string]string{"authority": "FACTSET"}, Status: 200, Body: "authority"})
+	server.SetFixture("GET", "/concepts", Fixture{QueryParameters: map[string]string{"authority": "FACTSET", "identifierValue": "05HVRR-E"}, Status: 200, Body: "identifier"})
+	server.SetFixture("GET", "/concepts", Fixture{QueryParameters: map[string]string{"authority": "FACTSET"}, Status: 200, Body: "replaced"})
+
+	type testCase struct {
+		name     string
+		query    string
+		expected string
+	}
+	testCases := []testCase{
+		{"No parameters", "", `"any"`},
+		{"Unknown parameters", "?industryClassification=38ee195d-ebdd-48a9-af4b-c8a322e7b04d", `"any"`},
+		{"Some parameters", "?authority=FACTSET&identifierValue=000C7F-E", `"replaced"`},
+		{"Every parameter", "?identifierValue=05HVRR-E&authority=FACTSET", `"identifier"`},
+	}
+
+	for _, test := range testCases {
+		resp, err := http.Get(server.URL + "/concepts" + test.query)
+		assert.NoError(t, err, test.name+" failed: the request was unsuccessful!")
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		assert.Equal(t, test.expected, string(body), test.name+" failed: bodies do not match!")
+	}
+}
+
+func TestServerInjectsFaults(t *testing.T) {
+	server, err := NewServer("../_ft/ersatz-fixtures.yml")
+	assert.NoError(t, err)
+	defer server.Close()
+	client := http.Client{Timeout: 50 * time.Millisecond}
+	conceptPath := "/concepts/" + spotUUID
+
+	server.InjectFault(conceptPath, FaultServerError)
+	resp, err := client.Get(server.URL + conceptPath)
+	assert.NoError(t, err)
+	assert.Equal(t, 500, resp.StatusCode)
+
+	server.InjectFault("", FaultUnavailable)
+	resp, err = client.Get(server.URL + "/__gtg")
+	assert.NoError(t, err)
+	assert.Equal(t, 503, resp.StatusCode)
+	server.InjectFault("", NoFault)
+
+	server.InjectFault(conceptPath, FaultMalformedJSON)
+	resp, err = client.Get(server.URL + conceptPath)
+	assert.NoError(t, err)
+	body, _ := ioutil.ReadAll(resp.Body)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, `{"id": "http://www.ft.com/thing/`, string(body))
+
+	server.InjectFault(conceptPath, FaultTimeout)
+	_, err = client.Get(server.URL + conceptPath)
+	assert.Error(t, err)
+
+	server.InjectFault(conceptPath, NoFault)
+	server.SetLatency(100 * time.Millisecond)
+	_, err = client.Get(server.URL + conceptPath)
+	assert.Error(t, err)
+
+	server.SetLatency(10 * time.Millisecond)
+	start := time.Now()
+	resp, err = client.Get(server.URL + conceptPath)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.True(t, time.Since(start) >= 10*time.Millisecond)
+}
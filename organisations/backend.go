@@ -0,0 +1,93 @@
+package organisations
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	logger "github.com/Financial-Times/go-logger"
+)
+
+// Backend lists organisations from the store behind the API
+type Backend interface {
+	// OrganisationsByIndustryClassification lists the organisations classified with the industry classification concept.
+	// found is false if there is no such classification.
+	OrganisationsByIndustryClassification(classificationUUID string, transID string) (organisations []ConceptSummary, found bool, err error)
+}
+
+// ConceptsAPIBackend lists organisations by searching public-concepts-api
+type ConceptsAPIBackend struct {
+	client      HTTPClient
+	conceptsURL string
+}
+
+func NewConceptsAPIBackend(client HTTPClient, conceptsURL string) *ConceptsAPIBackend {
+	return &ConceptsAPIBackend{client, conceptsURL}
+}
+
+type conceptSearchResponse struct {
+	Concepts []Concept `json:"concepts"`
+}
+
+func (b *ConceptsAPIBackend) OrganisationsByIndustryClassification(classificationUUID string, transID string) ([]ConceptSummary, bool, error) {
+	query := url.Values{}
+	query.Set("type", ontologyPrefix+organisationSuffix)
+	query.Set("industryClassification", classificationUUID)
+	reqURL := b.conceptsURL + "/concepts?" + query.Encode()
+
+	request, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		msg := fmt.Sprintf("failed to create request to %s", reqURL)
+		logger.WithError(err).WithUUID(classificationUUID).WithTransactionID(transID).Error(msg)
+		return nil, false, err
+	}
+
+	request.Header.Set("X-Request-Id", transID)
+	resp, err := b.client.Do(request)
+	if err != nil {
+		msg := fmt.Sprintf("request to %s was unsuccessful", reqURL)
+		logger.WithError(err).WithUUID(classificationUUID).WithTransactionID(transID).Error(msg)
+		return nil, false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("request to %s returned a non-200 HTTP status: %v", reqURL, resp.StatusCode)
+		logger.WithError(err).WithUUID(classificationUUID).WithTransactionID(transID).Error("failed to search concepts")
+		return nil, false, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		msg := fmt.Sprintf("failed to read response body: %v", resp.Body)
+		logger.WithError(err).WithUUID(classificationUUID).WithTransactionID(transID).Error(msg)
+		return nil, false, err
+	}
+
+	searchResponse := conceptSearchResponse{}
+	if err = json.Unmarshal(body, &searchResponse); err != nil {
+		msg := fmt.Sprintf("failed to unmarshal response body: %v", body)
+		logger.WithError(err).WithUUID(classificationUUID).WithTransactionID(transID).Error(msg)
+		return nil, false, newContractViolation(reqURL, err.Error())
+	}
+
+	organisations := []ConceptSummary{}
+	problems := []string{}
+	for i, concept := range searchResponse.Concepts {
+		if isOrganisationType(concept.Type) {
+			problems = append(problems, validateSummary(fmt.Sprintf("concepts[%d].", i), concept)...)
+			organisations = append(organisations, newConceptSummary(concept))
+		}
+	}
+	if len(problems) > 0 {
+		err = newContractViolation(reqURL, problems...)
+		logger.WithError(err).WithUUID(classificationUUID).WithTransactionID(transID).Error("invalid concepts")
+		return nil, false, err
+	}
+	return organisations, true, nil
+}
@@ -0,0 +1,185 @@
+package organisations
+
+import (
+	"container/list"
+	"regexp"
+	"sync"
+	"time"
+)
+
+var uuidMatcher = regexp.MustCompile("[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}")
+
+func isUUID(s string) bool {
+	return len(s) == 36 && uuidMatcher.MatchString(s)
+}
+
+// Cache keeps mapped organisations in memory, keyed by the UUID they were requested with.
+// Every entry is also indexed by the UUIDs it references (its canonical UUID and the UUIDs of
+// its embedded parent, subsidiaries and financial instrument) so that a change to any of those
+// concepts can evict it. Once it holds its maximum number of entries, caching another organisation
+// evicts the least recently used one, so entries that expire without being requested again do not
+// stay in memory.
+type Cache struct {
+	sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	entries    map[string]*list.Element
+	recency    *list.List
+	refs       map[string]map[string]bool
+	now        func() time.Time
+}
+
+type cacheEntry struct {
+	key          string
+	organisation Organisation
+	refs         []string
+	expires      time.Time
+}
+
+// NewCache returns an empty cache whose entries live for the given duration, holding at most maxEntries organisations
+func NewCache(ttl time.Duration, maxEntries int) *Cache {
+	return &Cache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		recency:    list.New(),
+		refs:       make(map[string]map[string]bool),
+		now:        time.Now,
+	}
+}
+
+// Get returns the organisation cached for the requested UUID, if it has not expired.
+// The returned organisation is shared with the cache and must not be modified.
+func (c *Cache) Get(uuid string) (Organisation, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	element, found := c.entries[uuid]
+	if !found {
+		return Organisation{}, false
+	}
+	entry := element.Value.(*cacheEntry)
+	if c.now().After(entry.expires) {
+		c.remove(uuid)
+		return Organisation{}, false
+	}
+	c.recency.MoveToFront(element)
+	return entry.organisation, true
+}
+
+// Set caches the organisation returned for the requested UUID
+func (c *Cache) Set(uuid string, organisation Organisation) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.remove(uuid)
+	for len(c.entries) >= c.maxEntries && c.recency.Len() > 0 {
+		c.remove(c.recency.Back().Value.(*cacheEntry).key)
+	}
+	refs := referencedUUIDs(organisation, uuid)
+	c.entries[uuid] = c.recency.PushFront(&cacheEntry{key: uuid, organisation: organisation, refs: refs, expires: c.now().Add(c.ttl)})
+	for _, ref := range refs {
+		if c.refs[ref] == nil {
+			c.refs[ref] = make(map[string]bool)
+		}
+		c.refs[ref][uuid] = true
+	}
+}
+
+// Evict removes every cached organisation that was requested with, or references, any of the given UUIDs.
+// It returns the number of entries removed.
+func (c *Cache) Evict(uuids ...string) int {
+	c.Lock()
+	defer c.Unlock()
+
+	evicted := 0
+	for _, uuid := range uuids {
+		for key := range c.refs[uuid] {
+			if c.remove(key) {
+				evicted++
+			}
+		}
+		if c.remove(uuid) {
+			evicted++
+		}
+	}
+	return evicted
+}
+
+// Len returns the number of cached organisations
+func (c *Cache) Len() int {
+	c.Lock()
+	defer c.Unlock()
+	return len(c.entries)
+}
+
+func (c *Cache) remove(key string) bool {
+	element, found := c.entries[key]
+	if !found {
+		return false
+	}
+	delete(c.entries, key)
+	c.recency.Remove(element)
+	for _, ref := range element.Value.(*cacheEntry).refs {
+		delete(c.refs[ref], key)
+		if len(c.refs[ref]) == 0 {
+			delete(c.refs, ref)
+		}
+	}
+	return true
+}
+
+// referencedUUIDs lists, without duplicates, the canonical UUID of the organisation, its aliases,
+// the given additional UUIDs and the UUIDs of every concept embedded in it
+func referencedUUIDs(organisation Organisation, additional ...string) []string {
+	seen := map[string]bool{}
+	refs := []string{}
+	add := func(id string) {
+		uuid := uuidMatcher.FindString(id)
+		if uuid != "" && !seen[uuid] {
+			seen[uuid] = true
+			refs = append(refs, uuid)
+		}
+	}
+
+	add(organisation.ID)
+	for _, id := range organisation.Aliases {
+		add(id)
+	}
+	for _, id := range additional {
+		add(id)
+	}
+	if organisation.Parent != nil {
+		add(organisation.Parent.ID)
+	}
+	for _, parent := range organisation.ParentOrganisations {
+		add(parent.ID)
+	}
+	for _, subsidiary := range organisation.Subsidiaries {
+		add(subsidiary.ID)
+	}
+	if organisation.FinancialInstrument != nil {
+		add(organisation.FinancialInstrument.ID)
+	}
+	for _, instrument := range organisation.FinancialInstruments {
+		add(instrument.ID)
+	}
+	for _, concept := range organisation.BroaderConcepts {
+		add(concept.ID)
+	}
+	for _, concept := range organisation.NarrowerConcepts {
+		add(concept.ID)
+	}
+	if organisation.ReplacedBy != nil {
+		add(organisation.ReplacedBy.ID)
+	}
+	for _, classification := range organisation.IndustryClassifications {
+		add(classification.ID)
+	}
+	for _, concepts := range organisation.Related {
+		for _, concept := range concepts {
+			add(concept.ID)
+		}
+	}
+	return refs
+}
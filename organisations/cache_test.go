@@ -0,0 +1,89 @@
+package organisations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	cachedOrganisationUUID = "7c5218a0-3755-463e-abbc-1a1632cfd1da"
+	cachedAliasUUID        = "2d3e16e0-61cb-4322-8aff-3b01c59f4daa"
+	cachedParentUUID       = "335e9e5a-8f2e-11e8-8f42-da24cd01f044"
+	cachedSubsidiaryUUID   = "1b070fbb-6331-3225-bb57-9108deb67df4"
+)
+
+func cachedOrganisation() Organisation {
+	org := Organisation{}
+	org.ID = thingsApiUrl + cachedOrganisationUUID
+	org.Parent = &Parent{}
+	org.Parent.ID = thingsApiUrl + cachedParentUUID
+	subsidiary := Subsidiary{}
+	subsidiary.ID = thingsApiUrl + cachedSubsidiaryUUID
+	org.Subsidiaries = []Subsidiary{subsidiary}
+	return org
+}
+
+func TestCacheGetReturnsCachedOrganisation(t *testing.T) {
+	cache := NewCache(time.Minute, 100)
+	cache.Set(cachedOrganisationUUID, cachedOrganisation())
+
+	org, found := cache.Get(cachedOrganisationUUID)
+	assert.True(t, found)
+	assert.Equal(t, thingsApiUrl+cachedOrganisationUUID, org.ID)
+
+	_, found = cache.Get(cachedAliasUUID)
+	assert.False(t, found)
+}
+
+func TestCacheGetIgnoresExpiredEntries(t *testing.T) {
+	now := time.Now()
+	cache := NewCache(time.Minute, 100)
+	cache.now = func() time.Time { return now }
+	cache.Set(cachedOrganisationUUID, cachedOrganisation())
+
+	cache.now = func() time.Time { return now.Add(2 * time.Minute) }
+	_, found := cache.Get(cachedOrganisationUUID)
+	assert.False(t, found)
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestCacheEvictRemovesEntriesReferencingUUID(t *testing.T) {
+	testCases := []struct {
+		name    string
+		uuid    string
+		evicted int
+	}{
+		{"canonical organisation", cachedOrganisationUUID, 2},
+		{"alias", cachedAliasUUID, 1},
+		{"embedded parent", cachedParentUUID, 2},
+		{"embedded subsidiary", cachedSubsidiaryUUID, 2},
+		{"unrelated concept", "f92a4ca4-84f9-11e8-8f42-da24cd01f044", 0},
+	}
+
+	for _, test := range testCases {
+		cache := NewCache(time.Minute, 100)
+		cache.Set(cachedOrganisationUUID, cachedOrganisation())
+		cache.Set(cachedAliasUUID, cachedOrganisation())
+
+		assert.Equal(t, test.evicted, cache.Evict(test.uuid), test.name+" failed: evicted count does not match!")
+		assert.Equal(t, 2-test.evicted, cache.Len(), test.name+" failed: cache size does not match!")
+	}
+}
+
+func TestCacheSetEvictsLeastRecentlyUsedEntry(t *testing.T) {
+	cache := NewCache(time.Minute, 2)
+	cache.Set(cachedOrganisationUUID, cachedOrganisation())
+	cache.Set(cachedAliasUUID, cachedOrganisation())
+	cache.Get(cachedOrganisationUUID)
+	cache.Set(cachedParentUUID, cachedOrganisation())
+
+	assert.Equal(t, 2, cache.Len())
+	_, found := cache.Get(cachedAliasUUID)
+	assert.False(t, found, "least recently used entry should have been evicted")
+	_, found = cache.Get(cachedOrganisationUUID)
+	assert.True(t, found, "recently used entry should have been kept")
+	_, found = cache.Get(cachedParentUUID)
+	assert.True(t, found, "latest entry should have been kept")
+}
@@ -0,0 +1,98 @@
+package organisations
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CacheDirectives are the Cache-Control settings sent with one class of response.
+// Zero durations are left out of the header, and no header is sent if every duration is zero.
+type CacheDirectives struct {
+	MaxAge               time.Duration
+	SMaxAge              time.Duration
+	StaleWhileRevalidate time.Duration
+	StaleIfError         time.Duration
+}
+
+// CachePolicy holds the cache directives for every class of organisation response
+type CachePolicy struct {
+	OK         CacheDirectives
+	Redirect   CacheDirectives
+	NotFound   CacheDirectives
+	Deprecated CacheDirectives
+}
+
+// NewCachePolicy returns a policy caching found organisations, deprecated organisations and
+// canonical redirects for the given duration, and not caching missing organisations
+func NewCachePolicy(maxAge time.Duration) CachePolicy {
+	d := CacheDirectives{MaxAge: maxAge}
+	return CachePolicy{OK: d, Redirect: d, Deprecated: d}
+}
+
+// ParseCacheDirectives parses a comma separated list of directives such as
+// "max-age=30s, s-maxage=5m, stale-while-revalidate=1m, stale-if-error=24h".
+// Values are either durations or a number of seconds, and may not be negative.
+func ParseCacheDirectives(s string) (CacheDirectives, error) {
+	d := CacheDirectives{}
+	for _, directive := range strings.Split(s, ",") {
+		directive = strings.TrimSpace(directive)
+		if directive == "" {
+			continue
+		}
+		parts := strings.SplitN(directive, "=", 2)
+		if len(parts) != 2 {
+			return d, fmt.Errorf("cache directive '%s' has no value", directive)
+		}
+		value, err := parseSeconds(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return d, fmt.Errorf("cache directive '%s' has an invalid value: %v", directive, err)
+		}
+		if value < 0 {
+			return d, fmt.Errorf("cache directive '%s' has a negative value", directive)
+		}
+		switch strings.ToLower(strings.TrimSpace(parts[0])) {
+		case "max-age":
+			d.MaxAge = value
+		case "s-maxage":
+			d.SMaxAge = value
+		case "stale-while-revalidate":
+			d.StaleWhileRevalidate = value
+		case "stale-if-error":
+			d.StaleIfError = value
+		default:
+			return d, fmt.Errorf("unsupported cache directive '%s'", directive)
+		}
+	}
+	return d, nil
+}
+
+func parseSeconds(value string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return time.ParseDuration(value)
+}
+
+// Header renders the directives as a Cache-Control header value, e.g. "max-age=30, public, stale-if-error=86400"
+func (d CacheDirectives) Header() string {
+	if d == (CacheDirectives{}) {
+		return ""
+	}
+	directives := []string{fmt.Sprintf("max-age=%s", seconds(d.MaxAge)), "public"}
+	if d.SMaxAge > 0 {
+		directives = append(directives, fmt.Sprintf("s-maxage=%s", seconds(d.SMaxAge)))
+	}
+	if d.StaleWhileRevalidate > 0 {
+		directives = append(directives, fmt.Sprintf("stale-while-revalidate=%s", seconds(d.StaleWhileRevalidate)))
+	}
+	if d.StaleIfError > 0 {
+		directives = append(directives, fmt.Sprintf("stale-if-error=%s", seconds(d.StaleIfError)))
+	}
+	return strings.Join(directives, ", ")
+}
+
+func seconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', 0, 64)
+}
@@ -0,0 +1,44 @@
+package organisations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCacheDirectives(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		expected CacheDirectives
+		hasError bool
+	}{
+		{"empty", "", CacheDirectives{}, false},
+		{"durations", "max-age=30s, s-maxage=5m, stale-while-revalidate=1m, stale-if-error=24h", CacheDirectives{30 * time.Second, 5 * time.Minute, time.Minute, 24 * time.Hour}, false},
+		{"seconds", "max-age=30,stale-if-error=3600", CacheDirectives{MaxAge: 30 * time.Second, StaleIfError: time.Hour}, false},
+		{"unsupported directive", "no-cache=1", CacheDirectives{}, true},
+		{"missing value", "max-age", CacheDirectives{}, true},
+		{"invalid value", "max-age=forever", CacheDirectives{}, true},
+		{"negative seconds", "max-age=-30", CacheDirectives{}, true},
+		{"negative duration", "stale-while-revalidate=-1m", CacheDirectives{}, true},
+	}
+
+	for _, test := range testCases {
+		d, err := ParseCacheDirectives(test.value)
+		if test.hasError {
+			assert.Error(t, err, test.name+" failed: expected an error")
+			continue
+		}
+		assert.NoError(t, err, test.name+" failed: unexpected error")
+		assert.Equal(t, test.expected, d, test.name+" failed: directives do not match!")
+	}
+}
+
+func TestCacheDirectivesHeader(t *testing.T) {
+	assert.Equal(t, "", CacheDirectives{}.Header())
+	assert.Equal(t, "max-age=30, public", CacheDirectives{MaxAge: 30 * time.Second}.Header())
+	assert.Equal(t, "max-age=0, public, stale-if-error=60", CacheDirectives{StaleIfError: time.Minute}.Header())
+	assert.Equal(t, "max-age=30, public, s-maxage=300, stale-while-revalidate=60, stale-if-error=86400",
+		CacheDirectives{30 * time.Second, 5 * time.Minute, time.Minute, 24 * time.Hour}.Header())
+}
@@ -0,0 +1,55 @@
+package organisations
+
+import (
+	"encoding/csv"
+	"strings"
+)
+
+// Country is an ISO 3166-1 country, used to expand the country codes of an organisation
+type Country struct {
+	Code   string `json:"code"`
+	Alpha3 string `json:"alpha3"`
+	Name   string `json:"name"`
+	Region string `json:"region"`
+}
+
+var countries = loadCountries(countriesCSV)
+
+func loadCountries(data string) map[string]Country {
+	records, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+	if err != nil {
+		panic("invalid country dataset: " + err.Error())
+	}
+	byCode := make(map[string]Country, len(records))
+	for _, record := range records {
+		byCode[record[0]] = Country{Code: record[0], Alpha3: record[1], Name: record[2], Region: record[3]}
+	}
+	return byCode
+}
+
+// lookupCountry finds the country for an ISO 3166-1 alpha-2 code
+func lookupCountry(code string) (Country, bool) {
+	country, found := countries[strings.ToUpper(code)]
+	return country, found
+}
+
+// expandCountries adds the country details of both country codes to the organisation
+func expandCountries(organisation *Organisation) {
+	if country, found := lookupCountry(organisation.CountryCode); found {
+		organisation.CountryCodeDetails = &country
+	}
+	if country, found := lookupCountry(organisation.CountryOfIncorporation); found {
+		organisation.CountryOfIncorporationDetails = &country
+	}
+}
+
+// unknownCountryCodes lists the country codes of the organisation missing from the dataset
+func unknownCountryCodes(organisation Organisation) []string {
+	unknown := []string{}
+	for _, code := range []string{organisation.CountryCode, organisation.CountryOfIncorporation} {
+		if _, found := lookupCountry(code); code != "" && !found {
+			unknown = append(unknown, code)
+		}
+	}
+	return unknown
+}
@@ -0,0 +1,42 @@
+package organisations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupCountry(t *testing.T) {
+	country, found := lookupCountry("JP")
+	assert.True(t, found)
+	assert.Equal(t, Country{Code: "JP", Alpha3: "JPN", Name: "Japan", Region: "Asia"}, country)
+
+	country, found = lookupCountry("gb")
+	assert.True(t, found)
+	assert.Equal(t, "GBR", country.Alpha3)
+
+	_, found = lookupCountry("XX")
+	assert.False(t, found)
+	_, found = lookupCountry("")
+	assert.False(t, found)
+}
+
+func TestCountryDatasetIsComplete(t *testing.T) {
+	assert.Len(t, countries, 249)
+	for code, country := range countries {
+		assert.Len(t, code, 2, "alpha-2 code of "+country.Name)
+		assert.Len(t, country.Alpha3, 3, "alpha-3 code of "+country.Name)
+		assert.NotEmpty(t, country.Name, "name of "+code)
+		assert.NotEmpty(t, country.Region, "region of "+code)
+	}
+}
+
+func TestExpandCountries(t *testing.T) {
+	org := Organisation{CountryCode: "US", CountryOfIncorporation: "NL"}
+	expandCountries(&org)
+	assert.Equal(t, &Country{Code: "US", Alpha3: "USA", Name: "United States", Region: "Americas"}, org.CountryCodeDetails)
+	assert.Equal(t, &Country{Code: "NL", Alpha3: "NLD", Name: "Netherlands", Region: "Europe"}, org.CountryOfIncorporationDetails)
+
+	assert.Equal(t, []string{}, unknownCountryCodes(org))
+	assert.Equal(t, []string{"UK"}, unknownCountryCodes(Organisation{CountryCode: "UK"}))
+}
@@ -0,0 +1,115 @@
+package organisations
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const csvMediaType = "text/csv"
+
+// csvColumn is a column of the CSV summary of organisations
+type csvColumn struct {
+	name  string
+	value func(organisation Organisation) string
+}
+
+// csvColumns are the columns an organisation is flattened into, in their default order
+var csvColumns = []csvColumn{
+	{"id", func(o Organisation) string { return o.ID }},
+	{"prefLabel", func(o Organisation) string { return o.PrefLabel }},
+	{"properName", func(o Organisation) string { return o.ProperName }},
+	{"leiCode", func(o Organisation) string { return o.LegalEntityIdentifier }},
+	{"countryCode", func(o Organisation) string { return o.CountryCode }},
+	{"countryOfIncorporation", func(o Organisation) string { return o.CountryOfIncorporation }},
+	{"yearFounded", func(o Organisation) string {
+		if o.YearFounded == 0 {
+			return ""
+		}
+		return strconv.Itoa(o.YearFounded)
+	}},
+	{"parentId", func(o Organisation) string {
+		if o.Parent == nil {
+			return ""
+		}
+		return o.Parent.ID
+	}},
+	{"parentLabel", func(o Organisation) string {
+		if o.Parent == nil {
+			return ""
+		}
+		return o.Parent.PrefLabel
+	}},
+	{"subsidiaryCount", func(o Organisation) string { return strconv.Itoa(len(o.Subsidiaries)) }},
+	{"figi", func(o Organisation) string {
+		if o.FinancialInstrument == nil {
+			return ""
+		}
+		return o.FinancialInstrument.Figi
+	}},
+	{"isDeprecated", func(o Organisation) string { return strconv.FormatBool(o.IsDeprecated) }},
+}
+
+// parseCSVColumns reads the comma separated columns parameter, e.g. ?columns=id,prefLabel,figi. Every column is
+// selected when it is missing.
+func parseCSVColumns(r *http.Request) ([]csvColumn, error) {
+	param := strings.TrimSpace(r.URL.Query().Get("columns"))
+	if param == "" {
+		return csvColumns, nil
+	}
+	return csvColumnsNamed(strings.Split(param, ","))
+}
+
+// csvColumnsNamed returns the columns with the given names, in the given order
+func csvColumnsNamed(names []string) ([]csvColumn, error) {
+	selected := []csvColumn{}
+	for _, name := range names {
+		column, found := csvColumnNamed(strings.TrimSpace(name))
+		if !found {
+			return nil, fmt.Errorf("column '%s' is not one of %s", strings.TrimSpace(name), strings.Join(csvColumnNames(csvColumns), ", "))
+		}
+		selected = append(selected, column)
+	}
+	return selected, nil
+}
+
+func csvColumnNamed(name string) (csvColumn, bool) {
+	for _, column := range csvColumns {
+		if column.name == name {
+			return column, true
+		}
+	}
+	return csvColumn{}, false
+}
+
+func csvColumnNames(columns []csvColumn) []string {
+	names := make([]string, 0, len(columns))
+	for _, column := range columns {
+		names = append(names, column.name)
+	}
+	return names
+}
+
+// csvRecord flattens the organisation into the values of the columns
+func csvRecord(organisation Organisation, columns []csvColumn) []string {
+	record := make([]string, 0, len(columns))
+	for _, column := range columns {
+		record = append(record, column.value(organisation))
+	}
+	return record
+}
+
+// SummaryColumns are the names of the columns organisations are summarised in by CSV exports, in their default order
+func SummaryColumns() []string {
+	return csvColumnNames(csvColumns)
+}
+
+// Summarise flattens the organisation into the values of the named columns, as CSV exports do
+func Summarise(organisation Organisation, columns []string) ([]string, error) {
+	selected, err := csvColumnsNamed(columns)
+	if err != nil {
+		return nil, err
+	}
+	return csvRecord(organisation, selected), nil
+}
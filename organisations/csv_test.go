@@ -0,0 +1,77 @@
+package organisations
+
+import (
+	"bytes"
+	"encoding/csv"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSVRecordEscaping(t *testing.T) {
+	type testCase struct {
+		name         string
+		organisation Organisation
+		expected     string
+	}
+	testCases := []testCase{
+		{"Plain", Organisation{Thing: Thing{PrefLabel: "Nintendo Co Ltd"}, YearFounded: 1889}, "Nintendo Co Ltd,1889,false,\r\n"},
+		{"Comma", Organisation{Thing: Thing{PrefLabel: "Nintendo, Co Ltd"}}, "\"Nintendo, Co Ltd\",,false,\r\n"},
+		{"Quote", Organisation{Thing: Thing{PrefLabel: `The "Big N"`}}, "\"The \"\"Big N\"\"\",,false,\r\n"},
+		{"LineBreak", Organisation{Thing: Thing{PrefLabel: "Nintendo\nCo Ltd"}, IsDeprecated: true}, "\"Nintendo\r\nCo Ltd\",,true,\r\n"},
+	}
+
+	columns := []csvColumn{}
+	for _, name := range []string{"prefLabel", "yearFounded", "isDeprecated"} {
+		column, _ := csvColumnNamed(name)
+		columns = append(columns, column)
+	}
+	for _, test := range testCases {
+		buf := &bytes.Buffer{}
+		writer := csvExportWriter{w: csv.NewWriter(buf), columns: columns}
+		writer.w.UseCRLF = true
+		err := writer.write(exportResult{organisation: test.organisation})
+
+		assert.NoError(t, err, test.name+" failed: the record was not written!")
+		assert.Equal(t, test.expected, buf.String(), test.name+" failed: records do not match!")
+	}
+}
+
+func TestParseCSVColumns(t *testing.T) {
+	type testCase struct {
+		name     string
+		query    string
+		expected []string
+		err      string
+	}
+	testCases := []testCase{
+		{"Default", "", csvColumnNames(csvColumns), ""},
+		{"Selected", "?columns=figi, id ,prefLabel", []string{"figi", "id", "prefLabel"}, ""},
+		{"Unknown", "?columns=id,name", nil, "column 'name' is not one of id, prefLabel, properName, leiCode, countryCode, countryOfIncorporation, yearFounded, parentId, parentLabel, subsidiaryCount, figi, isDeprecated"},
+	}
+
+	for _, test := range testCases {
+		req, _ := http.NewRequest("POST", "/organisations/export"+test.query, nil)
+		columns, err := parseCSVColumns(req)
+		if test.err != "" {
+			assert.EqualError(t, err, test.err, test.name+" failed: errors do not match!")
+			continue
+		}
+		assert.NoError(t, err, test.name+" failed: unexpected error!")
+		assert.Equal(t, test.expected, csvColumnNames(columns), test.name+" failed: columns do not match!")
+	}
+}
+
+func TestSummarise(t *testing.T) {
+	organisation := Organisation{Thing: Thing{ID: "http://api.ft.com/things/" + graphQLOrganisationUUID, PrefLabel: "Nintendo Co Ltd"},
+		Subsidiaries: []Subsidiary{{}, {}}}
+
+	record, err := Summarise(organisation, []string{"prefLabel", "subsidiaryCount", "figi"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Nintendo Co Ltd", "2", ""}, record)
+
+	_, err = Summarise(organisation, []string{"name"})
+	assert.Error(t, err)
+	assert.Equal(t, csvColumnNames(csvColumns), SummaryColumns())
+}
@@ -0,0 +1,28 @@
+package organisations
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Predicates relating a deprecated concept to its successor
+const (
+	isReplacedByPredicate = "/isReplacedBy"
+	mergedIntoPredicate   = "/mergedInto"
+)
+
+// successorUUID is the UUID of the organisation that replaced a deprecated organisation, if any
+func successorUUID(organisation Organisation) string {
+	if !organisation.IsDeprecated || organisation.ReplacedBy == nil {
+		return ""
+	}
+	return uuidMatcher.FindString(organisation.ReplacedBy.ID)
+}
+
+// setDeprecationHeaders marks the response as deprecated, linking to the successor organisation when there is one
+func setDeprecationHeaders(w http.ResponseWriter, successorUUID string) {
+	w.Header().Set("Deprecation", "true")
+	if successorUUID != "" {
+		w.Header().Set("Link", fmt.Sprintf(`</organisations/%s>; rel="successor-version"`, successorUUID))
+	}
+}
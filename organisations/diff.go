@@ -0,0 +1,158 @@
+package organisations
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Difference is a field with different values in two organisations. Field is the JSON path of the field, e.g.
+// parentOrganisation.prefLabel, types[1] or subsidiaries[id=http://api.ft.com/things/<uuid>].prefLabel; a value is nil
+// when the field is missing.
+type Difference struct {
+	Field string      `json:"field"`
+	Left  interface{} `json:"left"`
+	Right interface{} `json:"right"`
+}
+
+// Diff compares the organisations as they are returned, field by field, with the fields of each object sorted by name.
+// Arrays of objects that all have a distinct id, or otherwise uuid, are compared object by object whatever their order;
+// other arrays are compared item by item.
+func Diff(left Organisation, right Organisation) ([]Difference, error) {
+	leftValue, err := jsonValue(left)
+	if err != nil {
+		return nil, err
+	}
+	rightValue, err := jsonValue(right)
+	if err != nil {
+		return nil, err
+	}
+	differences := []Difference{}
+	diffValues("", leftValue, rightValue, &differences)
+	return differences, nil
+}
+
+// jsonValue is the organisation as decoded from its JSON, in maps, slices and scalar values
+func jsonValue(organisation Organisation) (interface{}, error) {
+	body, err := json.Marshal(organisation)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	err = json.Unmarshal(body, &value)
+	return value, err
+}
+
+func diffValues(field string, left interface{}, right interface{}, differences *[]Difference) {
+	leftObject, leftIsObject := left.(map[string]interface{})
+	rightObject, rightIsObject := right.(map[string]interface{})
+	if leftIsObject && rightIsObject {
+		for _, key := range unionKeys(leftObject, rightObject) {
+			diffValues(joinField(field, key), leftObject[key], rightObject[key], differences)
+		}
+		return
+	}
+
+	leftArray, leftIsArray := left.([]interface{})
+	rightArray, rightIsArray := right.([]interface{})
+	if leftIsArray && rightIsArray {
+		if key, found := identifyingKey(leftArray, rightArray); found {
+			diffIdentifiedArrays(field, key, leftArray, rightArray, differences)
+			return
+		}
+		for i := 0; i < len(leftArray) || i < len(rightArray); i++ {
+			var leftItem, rightItem interface{}
+			if i < len(leftArray) {
+				leftItem = leftArray[i]
+			}
+			if i < len(rightArray) {
+				rightItem = rightArray[i]
+			}
+			diffValues(fmt.Sprintf("%s[%d]", field, i), leftItem, rightItem, differences)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(left, right) {
+		*differences = append(*differences, Difference{Field: field, Left: left, Right: right})
+	}
+}
+
+// identifyingKeys are the fields identifying the objects of an array, best first
+var identifyingKeys = []string{"id", "uuid"}
+
+// identifyingKey is the first identifying key every object of the arrays has a distinct string value for, if any
+func identifyingKey(arrays ...[]interface{}) (string, bool) {
+	for _, key := range identifyingKeys {
+		if identifiedBy(key, arrays...) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+func identifiedBy(key string, arrays ...[]interface{}) bool {
+	for _, array := range arrays {
+		seen := map[string]bool{}
+		for _, item := range array {
+			value := identity(item, key)
+			if value == "" || seen[value] {
+				return false
+			}
+			seen[value] = true
+		}
+	}
+	return true
+}
+
+// identity is the value of the key of the item, or empty if the item is not an object or the value not a string
+func identity(item interface{}, key string) string {
+	object, isObject := item.(map[string]interface{})
+	if !isObject {
+		return ""
+	}
+	value, _ := object[key].(string)
+	return value
+}
+
+// diffIdentifiedArrays compares the objects of the arrays with the same identity, in the order of the left array then
+// of the objects only in the right one
+func diffIdentifiedArrays(field string, key string, left []interface{}, right []interface{}, differences *[]Difference) {
+	rightItems := map[string]interface{}{}
+	for _, item := range right {
+		rightItems[identity(item, key)] = item
+	}
+	leftItems := map[string]bool{}
+	for _, item := range left {
+		value := identity(item, key)
+		leftItems[value] = true
+		diffValues(fmt.Sprintf("%s[%s=%s]", field, key, value), item, rightItems[value], differences)
+	}
+	for _, item := range right {
+		if value := identity(item, key); !leftItems[value] {
+			diffValues(fmt.Sprintf("%s[%s=%s]", field, key, value), nil, item, differences)
+		}
+	}
+}
+
+func unionKeys(left map[string]interface{}, right map[string]interface{}) []string {
+	keys := []string{}
+	for key := range left {
+		keys = append(keys, key)
+	}
+	for key := range right {
+		if _, found := left[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func joinField(parent string, key string) string {
+	if parent == "" {
+		return key
+	}
+	return parent + "." + key
+}
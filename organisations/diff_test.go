@@ -0,0 +1,84 @@
+package organisations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	base := func() Organisation {
+		return Organisation{
+			Thing:        Thing{ID: "http://api.ft.com/things/" + graphQLOrganisationUUID, PrefLabel: "Nintendo Co Ltd"},
+			Types:        []string{"http://www.ft.com/ontology/organisation/Organisation"},
+			Parent:       &Parent{Thing: Thing{ID: "http://api.ft.com/things/" + graphQLParentUUID}},
+			Subsidiaries: []Subsidiary{{Thing: Thing{ID: "http://api.ft.com/things/" + graphQLSubsidiaryUUID}}},
+		}
+	}
+
+	type testCase struct {
+		name     string
+		change   func(o *Organisation)
+		expected []Difference
+	}
+	testCases := []testCase{
+		{"Identical", func(o *Organisation) {}, []Difference{}},
+		{"Scalar", func(o *Organisation) { o.PrefLabel = "Nintendo" },
+			[]Difference{{Field: "prefLabel", Left: "Nintendo Co Ltd", Right: "Nintendo"}}},
+		{"Added", func(o *Organisation) { o.YearFounded = 1889 },
+			[]Difference{{Field: "yearFounded", Left: nil, Right: float64(1889)}}},
+		{"Nested", func(o *Organisation) { o.Parent.PrefLabel = "Nintendo Holdings" },
+			[]Difference{{Field: "parentOrganisation.prefLabel", Left: nil, Right: "Nintendo Holdings"}}},
+		{"Removed", func(o *Organisation) { o.Parent = nil },
+			[]Difference{{Field: "parentOrganisation", Left: map[string]interface{}{"id": "http://api.ft.com/things/" + graphQLParentUUID, "apiUrl": ""}, Right: nil}}},
+		{"ArrayItems", func(o *Organisation) {
+			o.Subsidiaries = append(o.Subsidiaries, Subsidiary{Thing: Thing{ID: "http://api.ft.com/things/" + graphQLSubsidiary2UUID}})
+			o.Subsidiaries[0].PrefLabel = "Nintendo France SARL"
+		}, []Difference{
+			{Field: "subsidiaries[id=http://api.ft.com/things/" + graphQLSubsidiaryUUID + "].prefLabel", Left: nil, Right: "Nintendo France SARL"},
+			{Field: "subsidiaries[id=http://api.ft.com/things/" + graphQLSubsidiary2UUID + "]", Left: nil, Right: map[string]interface{}{"id": "http://api.ft.com/things/" + graphQLSubsidiary2UUID, "apiUrl": ""}},
+		}},
+		{"Reordered", func(o *Organisation) {
+			o.Subsidiaries = []Subsidiary{{Thing: Thing{ID: "http://api.ft.com/things/" + graphQLSubsidiary2UUID}}, o.Subsidiaries[0]}
+			o.Types = []string{"http://www.ft.com/ontology/organisation/Organisation", "http://www.ft.com/ontology/company/Company"}
+		}, []Difference{
+			{Field: "subsidiaries[id=http://api.ft.com/things/" + graphQLSubsidiary2UUID + "]", Left: nil, Right: map[string]interface{}{"id": "http://api.ft.com/things/" + graphQLSubsidiary2UUID, "apiUrl": ""}},
+			{Field: "types[1]", Left: nil, Right: "http://www.ft.com/ontology/company/Company"},
+		}},
+		{"ArrayItemRemoved", func(o *Organisation) { o.Subsidiaries = []Subsidiary{} },
+			[]Difference{{Field: "subsidiaries", Left: []interface{}{map[string]interface{}{"id": "http://api.ft.com/things/" + graphQLSubsidiaryUUID, "apiUrl": ""}}, Right: nil}}},
+		{"ArrayItemReplaced", func(o *Organisation) {
+			o.Subsidiaries = []Subsidiary{{Thing: Thing{ID: "http://api.ft.com/things/" + graphQLSubsidiary2UUID}}}
+		}, []Difference{
+			{Field: "subsidiaries[id=http://api.ft.com/things/" + graphQLSubsidiaryUUID + "]", Left: map[string]interface{}{"id": "http://api.ft.com/things/" + graphQLSubsidiaryUUID, "apiUrl": ""}, Right: nil},
+			{Field: "subsidiaries[id=http://api.ft.com/things/" + graphQLSubsidiary2UUID + "]", Left: nil, Right: map[string]interface{}{"id": "http://api.ft.com/things/" + graphQLSubsidiary2UUID, "apiUrl": ""}},
+		}},
+		{"DuplicateIDs", func(o *Organisation) {
+			o.Subsidiaries = append(o.Subsidiaries, Subsidiary{Thing: Thing{ID: "http://api.ft.com/things/" + graphQLSubsidiaryUUID, PrefLabel: "Nintendo France SARL"}})
+		}, []Difference{
+			{Field: "subsidiaries[1]", Left: nil, Right: map[string]interface{}{"id": "http://api.ft.com/things/" + graphQLSubsidiaryUUID, "apiUrl": "", "prefLabel": "Nintendo France SARL"}},
+		}},
+	}
+
+	for _, test := range testCases {
+		right := base()
+		test.change(&right)
+		differences, err := Diff(base(), right)
+
+		assert.NoError(t, err, test.name+" failed: unexpected error!")
+		assert.Equal(t, test.expected, differences, test.name+" failed: differences do not match!")
+	}
+}
+
+func TestDiffMatchesArrayObjectsByUUID(t *testing.T) {
+	object := func(uuid string, label string) map[string]interface{} {
+		return map[string]interface{}{"uuid": uuid, "label": label}
+	}
+	left := []interface{}{object("a", "A"), object("b", "B")}
+	right := []interface{}{object("b", "B2"), object("a", "A")}
+
+	differences := []Difference{}
+	diffValues("items", left, right, &differences)
+
+	assert.Equal(t, []Difference{{Field: "items[uuid=b].label", Left: "B", Right: "B2"}}, differences)
+}
@@ -0,0 +1,265 @@
+package organisations
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	logger "github.com/Financial-Times/go-logger"
+	transactionidutils "github.com/Financial-Times/transactionid-utils-go"
+)
+
+// DefaultExportConcurrency is the number of organisations fetched at once by an export, unless configured otherwise
+const DefaultExportConcurrency = 8
+
+const ndjsonMediaType = "application/x-ndjson"
+
+// exportError is the line written in place of an organisation that could not be exported
+type exportError struct {
+	UUID  string `json:"uuid,omitempty"`
+	Error string `json:"error"`
+}
+
+// exportResult is an exported organisation, or the error it could not be exported with
+type exportResult struct {
+	uuid         string
+	organisation Organisation
+	err          string
+}
+
+// exportWriter writes the results of an export in one format
+type exportWriter interface {
+	start() error
+	write(result exportResult) error
+}
+
+// ExportOrganisations streams the organisations with the UUIDs of a newline delimited body, one JSON organisation per line
+// in the order the fetches complete. Lines that cannot be exported are reported inline as {"uuid": "...", "error": "..."}.
+// Clients accepting text/csv get a CSV summary instead, with the columns of the columns parameter and an error column.
+// The body is read while the response is written and the cache is bypassed, so memory use does not grow with the input.
+func (h *OrganisationsHandler) ExportOrganisations(w http.ResponseWriter, r *http.Request) {
+	transID := transactionidutils.GetTransactionIDFromRequest(r)
+	w.Header().Add("Vary", "Accept")
+
+	mediaType, acceptable := negotiateExportFormat(r)
+	if !acceptable {
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		w.WriteHeader(http.StatusNotAcceptable)
+		w.Write([]byte(`{"message": "exports are available as application/x-ndjson or text/csv"}`))
+		return
+	}
+	var writer exportWriter = ndjsonExportWriter{w: w}
+	contentType := ndjsonMediaType
+	if mediaType == csvMediaType {
+		columns, err := parseCSVColumns(r)
+		if err != nil {
+			w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+			w.WriteHeader(http.StatusBadRequest)
+			msg, _ := json.Marshal(map[string]string{"message": err.Error()})
+			w.Write(msg)
+			return
+		}
+		writer = csvExportWriter{w: csv.NewWriter(w), columns: columns}
+		contentType = csvMediaType + "; charset=UTF-8; header=present"
+	}
+
+	// reading the body after the response has started needs full duplex with HTTP/1.x; HTTP/2 is always full duplex
+	http.NewResponseController(w).EnableFullDuplex()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	if err := writer.start(); err != nil {
+		logger.WithTransactionID(transID).WithError(err).Warn("export stopped before the first line")
+		return
+	}
+
+	results := h.exportResults(ctx, r, transID)
+	flusher, _ := w.(http.Flusher)
+	written := 0
+	for result := range results {
+		if ctx.Err() != nil {
+			continue
+		}
+		if err := writer.write(result); err != nil {
+			logger.WithTransactionID(transID).WithError(err).Warnf("export stopped after %d lines", written)
+			cancel()
+			continue
+		}
+		written++
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+	logger.WithTransactionID(transID).Infof("exported %d lines", written)
+}
+
+// negotiateExportFormat picks NDJSON or CSV from the Accept header, NDJSON when either is accepted equally
+func negotiateExportFormat(r *http.Request) (string, bool) {
+	if strings.TrimSpace(r.Header.Get("Accept")) == "" {
+		return ndjsonMediaType, true
+	}
+	for _, accepted := range parseAccept(r.Header.Get("Accept")) {
+		switch accepted.mediaType {
+		case csvMediaType, "text/*":
+			return csvMediaType, true
+		case ndjsonMediaType, jsonMediaType, "application/*", "*/*":
+			return ndjsonMediaType, true
+		}
+	}
+	return "", false
+}
+
+// exportResults reads the UUIDs and fetches them with bounded concurrency, closing the returned channel once every result is sent to it
+func (h *OrganisationsHandler) exportResults(ctx context.Context, r *http.Request, transID string) <-chan exportResult {
+	uuids := make(chan string)
+	results := make(chan exportResult)
+	send := func(result exportResult) {
+		select {
+		case results <- result:
+		case <-ctx.Done():
+		}
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(uuids)
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			uuid := strings.TrimSpace(scanner.Text())
+			if uuid == "" {
+				continue
+			}
+			select {
+			case uuids <- uuid:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			send(exportResult{err: fmt.Sprintf("failed to read uuids: %v", err)})
+		}
+	}()
+
+	for i := 0; i < h.exportConcurrency(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for uuid := range uuids {
+				if ctx.Err() != nil {
+					return
+				}
+				send(h.exportOrganisation(ctx, uuid, transID))
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	return results
+}
+
+// FetchOrganisation fetches and maps the organisation from public-concepts-api, bypassing the cache, in the default
+// schema version. It is used by the command line lookups.
+func (h *OrganisationsHandler) FetchOrganisation(uuid string, transID string) (Organisation, bool, error) {
+	if !isUUID(uuid) {
+		return Organisation{}, false, fmt.Errorf("uuid '%s' is invalid", uuid)
+	}
+	organisation, found, err := h.getOrganisationViaConceptsAPI(context.Background(), uuid, transID, requestOptions{})
+	if err != nil || !found {
+		return Organisation{}, found, err
+	}
+	return defaultVersion.shape(organisation), true, nil
+}
+
+// exportOrganisation fetches and maps one organisation, without caching it, in the default schema version.
+// The fetch is cancelled with the context.
+func (h *OrganisationsHandler) exportOrganisation(ctx context.Context, uuid string, transID string) exportResult {
+	if !isUUID(uuid) {
+		return exportResult{uuid: uuid, err: fmt.Sprintf("uuid '%s' is invalid", uuid)}
+	}
+	organisation, found, err := h.getOrganisationViaConceptsAPI(ctx, uuid, transID, requestOptions{})
+	if err != nil {
+		return exportResult{uuid: uuid, err: fetchErrorMessage(err)}
+	}
+	if !found {
+		return exportResult{uuid: uuid, err: "organisation not found"}
+	}
+	return exportResult{uuid: uuid, organisation: defaultVersion.shape(organisation)}
+}
+
+// ndjsonExportWriter writes each organisation, or exportError, as a line of JSON
+type ndjsonExportWriter struct {
+	w io.Writer
+}
+
+func (n ndjsonExportWriter) start() error {
+	return nil
+}
+
+func (n ndjsonExportWriter) write(result exportResult) error {
+	var line []byte
+	var err error
+	if result.err != "" {
+		line, err = json.Marshal(exportError{UUID: result.uuid, Error: result.err})
+	} else {
+		line, err = json.Marshal(result.organisation)
+	}
+	if err != nil {
+		line, _ = json.Marshal(exportError{UUID: result.uuid, Error: "organisation could not be marshalled"})
+	}
+	_, err = n.w.Write(append(line, '\n'))
+	return err
+}
+
+// csvExportWriter writes a header row, then a row per organisation following RFC 4180. Rows of organisations that could
+// not be exported only have the requested UUID in the id column, and the reason in the error column.
+type csvExportWriter struct {
+	w       *csv.Writer
+	columns []csvColumn
+}
+
+func (c csvExportWriter) start() error {
+	c.w.UseCRLF = true
+	return c.writeRecord(append(csvColumnNames(c.columns), "error"))
+}
+
+func (c csvExportWriter) write(result exportResult) error {
+	if result.err == "" {
+		return c.writeRecord(append(csvRecord(result.organisation, c.columns), ""))
+	}
+	record := make([]string, len(c.columns))
+	for i, column := range c.columns {
+		if column.name == "id" {
+			record[i] = result.uuid
+		}
+	}
+	return c.writeRecord(append(record, result.err))
+}
+
+func (c csvExportWriter) writeRecord(record []string) error {
+	if err := c.w.Write(record); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (h *OrganisationsHandler) exportConcurrency() int {
+	if h.exportWorkers <= 0 {
+		return DefaultExportConcurrency
+	}
+	return h.exportWorkers
+}
@@ -0,0 +1,207 @@
+package organisations
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportOrganisations(t *testing.T) {
+	mockClient := &mockRoutingHTTPClient{responses: graphQLResponses()}
+	router := mux.NewRouter()
+	bh := NewHandler(mockClient, "")
+	bh.RegisterHandlers(router)
+
+	body := strings.Join([]string{graphQLOrganisationUUID, "", "1234", "  " + graphQLParentUUID + "  ", "00000000-0000-0000-0000-000000000000"}, "\n")
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/organisations/export", strings.NewReader(body))
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+
+	lines := strings.Split(strings.TrimSuffix(rec.Body.String(), "\n"), "\n")
+	sort.Strings(lines)
+	assert.Len(t, lines, 4)
+	assert.Contains(t, lines[0], `"prefLabel":"Nintendo Holdings"`)
+	assert.Contains(t, lines[1], `"prefLabel":"Nintendo Co Ltd"`)
+	assert.Equal(t, `{"uuid":"00000000-0000-0000-0000-000000000000","error":"organisation not found"}`, lines[2])
+	assert.Equal(t, `{"uuid":"1234","error":"uuid '1234' is invalid"}`, lines[3])
+}
+
+func TestExportBoundsConcurrency(t *testing.T) {
+	mockClient := &slowHTTPClient{delay: 10 * time.Millisecond}
+	router := mux.NewRouter()
+	bh := NewHandler(mockClient, "")
+	bh.UseExportConcurrency(3)
+	bh.RegisterHandlers(router)
+
+	uuids := []string{}
+	for i := 0; i < 20; i++ {
+		uuids = append(uuids, graphQLOrganisationUUID)
+	}
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/organisations/export", strings.NewReader(strings.Join(uuids, "\n")))
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, 20, strings.Count(rec.Body.String(), "\n"))
+	assert.Equal(t, 3, mockClient.maxInFlight)
+}
+
+// slowHTTPClient returns a basic organisation after a delay, recording how many requests were in flight at once
+type slowHTTPClient struct {
+	delay       time.Duration
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+func (c *slowHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	c.inFlight++
+	if c.inFlight > c.maxInFlight {
+		c.maxInFlight = c.inFlight
+	}
+	c.mu.Unlock()
+
+	time.Sleep(c.delay)
+
+	c.mu.Lock()
+	c.inFlight--
+	c.mu.Unlock()
+	return &http.Response{Body: ioutil.NopCloser(bytes.NewReader([]byte(getBasicOrganisationAsConcept))), StatusCode: 200}, nil
+}
+
+func TestExportStopsWhenCancelled(t *testing.T) {
+	mockClient := &blockingHTTPClient{blocked: make(chan struct{}, 1)}
+	router := mux.NewRouter()
+	bh := NewHandler(mockClient, "")
+	bh.UseExportConcurrency(2)
+	bh.RegisterHandlers(router)
+
+	uuids := []string{}
+	for i := 0; i < 50; i++ {
+		uuids = append(uuids, graphQLOrganisationUUID)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(ctx, "POST", "/organisations/export", strings.NewReader(strings.Join(uuids, "\n")))
+
+	done := make(chan struct{})
+	go func() {
+		router.ServeHTTP(rec, req)
+		close(done)
+	}()
+
+	<-mockClient.blocked
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("export did not stop when its request was cancelled")
+	}
+
+	mockClient.mu.Lock()
+	defer mockClient.mu.Unlock()
+	assert.Less(t, mockClient.requests, len(uuids))
+	assert.Equal(t, mockClient.requests-1, mockClient.cancelled)
+	assert.NotContains(t, rec.Body.String(), `"error"`)
+}
+
+// blockingHTTPClient returns a basic organisation for the first request and blocks later ones until they are cancelled
+type blockingHTTPClient struct {
+	blocked   chan struct{}
+	mu        sync.Mutex
+	requests  int
+	cancelled int
+}
+
+func (c *blockingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	c.requests++
+	first := c.requests == 1
+	c.mu.Unlock()
+	if first {
+		return &http.Response{Body: ioutil.NopCloser(bytes.NewReader([]byte(getBasicOrganisationAsConcept))), StatusCode: 200}, nil
+	}
+
+	select {
+	case c.blocked <- struct{}{}:
+	default:
+	}
+	<-req.Context().Done()
+
+	c.mu.Lock()
+	c.cancelled++
+	c.mu.Unlock()
+	return nil, req.Context().Err()
+}
+
+func TestExportOrganisationsAsCSV(t *testing.T) {
+	mockClient := &mockRoutingHTTPClient{responses: graphQLResponses()}
+	router := mux.NewRouter()
+	bh := NewHandler(mockClient, "")
+	bh.UseExportConcurrency(1)
+	bh.RegisterHandlers(router)
+
+	body := strings.Join([]string{graphQLOrganisationUUID, "00000000-0000-0000-0000-000000000000"}, "\n")
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/organisations/export?columns=id,prefLabel,parentId,subsidiaryCount,figi", strings.NewReader(body))
+	req.Header.Set("Accept", "text/csv")
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, "text/csv; charset=UTF-8; header=present", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "id,prefLabel,parentId,subsidiaryCount,figi,error\r\n"+
+		"http://api.ft.com/things/"+graphQLOrganisationUUID+",Nintendo Co Ltd,http://api.ft.com/things/"+graphQLParentUUID+",2,BBG000BLCPP4,\r\n"+
+		"00000000-0000-0000-0000-000000000000,,,,,organisation not found\r\n", rec.Body.String())
+}
+
+func TestExportFormats(t *testing.T) {
+	type testCase struct {
+		name        string
+		accept      string
+		query       string
+		status      int
+		contentType string
+	}
+	testCases := []testCase{
+		{"NoAccept", "", "", 200, "application/x-ndjson"},
+		{"NDJSON", "application/x-ndjson", "", 200, "application/x-ndjson"},
+		{"Anything", "*/*", "", 200, "application/x-ndjson"},
+		{"CSV", "text/csv", "", 200, "text/csv; charset=UTF-8; header=present"},
+		{"CSVPreferred", "application/x-ndjson;q=0.5, text/csv", "", 200, "text/csv; charset=UTF-8; header=present"},
+		{"NDJSONIgnoresColumns", "application/x-ndjson", "?columns=unknown", 200, "application/x-ndjson"},
+		{"UnknownColumn", "text/csv", "?columns=id,unknown", 400, "application/json; charset=UTF-8"},
+		{"NotAcceptable", "application/xml", "", 406, "application/json; charset=UTF-8"},
+	}
+
+	for _, test := range testCases {
+		mockClient := &mockRoutingHTTPClient{responses: graphQLResponses()}
+		router := mux.NewRouter()
+		bh := NewHandler(mockClient, "")
+		bh.RegisterHandlers(router)
+
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/organisations/export"+test.query, strings.NewReader(graphQLOrganisationUUID))
+		if test.accept != "" {
+			req.Header.Set("Accept", test.accept)
+		}
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, test.status, rec.Code, test.name+" failed: status codes do not match!")
+		assert.Equal(t, test.contentType, rec.Header().Get("Content-Type"), test.name+" failed: content types do not match!")
+		assert.Equal(t, "Accept", rec.Header().Get("Vary"), test.name+" failed: vary headers do not match!")
+	}
+}
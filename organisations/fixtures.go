@@ -0,0 +1,164 @@
+package organisations
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	logger "github.com/Financial-Times/go-logger"
+)
+
+// Fixture directories hold a public-concepts-api response per concept: <uuid>.json is the body of a concept that is
+// found, and <uuid>.redirect the Location of a concept that redirects. Concepts with neither are not found. Concepts
+// requested with relationships other than related, such as broader and narrower concepts, are kept apart from those
+// requested with the default query, e.g. as <uuid>+broader+narrower.json.
+const (
+	fixtureBodySuffix     = ".json"
+	fixtureRedirectSuffix = ".redirect"
+)
+
+// FixtureClient replays public-concepts-api responses from a fixture directory, such as one written by a RecordingClient.
+// A concept requested with relationships that it has no fixture for is served from its default fixture, so that hand
+// written directories need a fixture per concept only. It is always good to go.
+type FixtureClient struct {
+	dir string
+}
+
+// NewFixtureClient returns a client serving the concept fixtures of the directory
+func NewFixtureClient(dir string) *FixtureClient {
+	return &FixtureClient{dir: dir}
+}
+
+func (c *FixtureClient) Do(req *http.Request) (*http.Response, error) {
+	if strings.HasSuffix(req.URL.Path, "/__gtg") {
+		return fixtureResponse(req, http.StatusOK, nil), nil
+	}
+	uuid := uuidMatcher.FindString(req.URL.Path)
+	if uuid == "" {
+		return fixtureResponse(req, http.StatusNotFound, nil), nil
+	}
+
+	names := []string{fixtureName(uuid, req.URL.Query())}
+	if names[0] != uuid {
+		names = append(names, uuid)
+	}
+	for _, name := range names {
+		resp, err := c.replay(req, name)
+		if resp != nil || err != nil {
+			return resp, err
+		}
+	}
+	return fixtureResponse(req, http.StatusNotFound, nil), nil
+}
+
+// replay returns the response recorded under the fixture name, or nil if there is none
+func (c *FixtureClient) replay(req *http.Request, name string) (*http.Response, error) {
+	body, err := ioutil.ReadFile(filepath.Join(c.dir, name+fixtureBodySuffix))
+	if err == nil {
+		return fixtureResponse(req, http.StatusOK, body), nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	location, err := ioutil.ReadFile(filepath.Join(c.dir, name+fixtureRedirectSuffix))
+	if err == nil {
+		resp := fixtureResponse(req, http.StatusMovedPermanently, nil)
+		resp.Header.Set("Location", strings.TrimSpace(string(location)))
+		return resp, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// fixtureName names the fixtures of a concept after its uuid and the relationships requested besides related, sorted
+func fixtureName(uuid string, query url.Values) string {
+	seen := map[string]bool{"related": true}
+	relationships := []string{}
+	for _, relationship := range query["showRelationship"] {
+		if !seen[relationship] {
+			seen[relationship] = true
+			relationships = append(relationships, url.PathEscape(relationship))
+		}
+	}
+	sort.Strings(relationships)
+	return strings.Join(append([]string{uuid}, relationships...), "+")
+}
+
+func fixtureResponse(req *http.Request, statusCode int, body []byte) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(bytes.NewReader(body)),
+		Request:    req,
+	}
+}
+
+// RecordingClient passes requests to public-concepts-api through to a client, and records the concepts that are found
+// or redirect into a fixture directory that a FixtureClient can replay. Other responses, such as errors, are not recorded.
+// Redirects are only recorded if the client does not follow them. Fixtures that cannot be written are logged, and the
+// response is returned all the same.
+type RecordingClient struct {
+	client HTTPClient
+	dir    string
+	mu     sync.Mutex
+}
+
+// NewRecordingClient returns a client recording the responses of the client into the directory, which is created if needed
+func NewRecordingClient(client HTTPClient, dir string) (*RecordingClient, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &RecordingClient{client: client, dir: dir}, nil
+}
+
+func (c *RecordingClient) Do(req *http.Request) (*http.Response, error) {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return resp, err
+	}
+	uuid := uuidMatcher.FindString(req.URL.Path)
+	if uuid == "" || !strings.Contains(req.URL.Path, "/concepts/") {
+		return resp, nil
+	}
+
+	name := fixtureName(uuid, req.URL.Query())
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		err = c.record(name, fixtureBodySuffix, body)
+	case isRedirect(resp.StatusCode):
+		err = c.record(name, fixtureRedirectSuffix, []byte(resp.Header.Get("Location")+"\n"))
+	}
+	if err != nil {
+		logger.WithError(err).WithUUID(uuid).WithTransactionID(req.Header.Get("X-Request-Id")).Error("failed to record fixture " + name)
+	}
+	return resp, nil
+}
+
+// record writes the fixture, replacing any other fixture of the same name
+func (c *RecordingClient) record(name string, suffix string, content []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, other := range []string{fixtureBodySuffix, fixtureRedirectSuffix} {
+		if other != suffix {
+			if err := os.Remove(filepath.Join(c.dir, name+other)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	return ioutil.WriteFile(filepath.Join(c.dir, name+suffix), content, 0644)
+}
@@ -0,0 +1,136 @@
+package organisations
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchOrganisationFromFixtures(t *testing.T) {
+	dir := t.TempDir()
+	responses := graphQLResponses()
+	for _, uuid := range []string{graphQLOrganisationUUID, graphQLParentUUID} {
+		body := responses["/concepts/"+uuid+"?showRelationship=related"].body
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, uuid+".json"), []byte(body), 0644))
+	}
+
+	type testCase struct {
+		name      string
+		uuid      string
+		found     bool
+		prefLabel string
+		err       string
+	}
+	testCases := []testCase{
+		{"Found", graphQLOrganisationUUID, true, "Nintendo Co Ltd", ""},
+		{"Parent", graphQLParentUUID, true, "Nintendo Holdings", ""},
+		{"NoFixture", graphQLSubsidiaryUUID, false, "", ""},
+		{"InvalidUUID", "1234", false, "", "uuid '1234' is invalid"},
+	}
+
+	bh := NewHandler(NewFixtureClient(dir), "")
+	for _, test := range testCases {
+		organisation, found, err := bh.FetchOrganisation(test.uuid, "tid_test")
+		if test.err != "" {
+			assert.EqualError(t, err, test.err, test.name+" failed: errors do not match!")
+			continue
+		}
+		assert.NoError(t, err, test.name+" failed: unexpected error!")
+		assert.Equal(t, test.found, found, test.name+" failed: found does not match!")
+		assert.Equal(t, test.prefLabel, organisation.PrefLabel, test.name+" failed: prefLabels do not match!")
+		assert.Nil(t, organisation.FinancialInstruments, test.name+" failed: the organisation is not in the default schema!")
+	}
+}
+
+func TestRecordAndReplayFixtures(t *testing.T) {
+	aliasUUID := "2c0c2b3e-94f9-4c8a-8b1b-7a1f7c2f0e4e"
+	responses := graphQLResponses()
+	responses["/concepts/"+aliasUUID+"?showRelationship=related"] = mockResponse{statusCode: 301, location: "/concepts/" + graphQLOrganisationUUID}
+	responses["/concepts/"+graphQLSubsidiaryUUID+"?showRelationship=related"] = mockResponse{statusCode: 503}
+
+	dir := filepath.Join(t.TempDir(), "fixtures")
+	recorder, err := NewRecordingClient(&mockRoutingHTTPClient{responses: responses}, dir)
+	assert.NoError(t, err)
+	recording := NewHandler(recorder, "")
+	replaying := NewHandler(NewFixtureClient(dir), "")
+
+	type testCase struct {
+		name     string
+		uuid     string
+		fixtures []string
+	}
+	testCases := []testCase{
+		{"Found", graphQLParentUUID, []string{graphQLParentUUID + ".json"}},
+		{"Redirect", aliasUUID, []string{aliasUUID + ".redirect", graphQLOrganisationUUID + ".json"}},
+		{"NotFound", "00000000-0000-0000-0000-000000000000", []string{}},
+		{"Error", graphQLSubsidiaryUUID, []string{}},
+	}
+
+	for _, test := range testCases {
+		recorded, recordedFound, _ := recording.FetchOrganisation(test.uuid, "tid_test")
+		replayed, replayedFound, err := replaying.FetchOrganisation(test.uuid, "tid_test")
+
+		assert.NoError(t, err, test.name+" failed: unexpected error!")
+		assert.Equal(t, recordedFound, replayedFound, test.name+" failed: found does not match!")
+		assert.Equal(t, recorded, replayed, test.name+" failed: organisations do not match!")
+		for _, fixture := range test.fixtures {
+			_, err := os.Stat(filepath.Join(dir, fixture))
+			assert.NoError(t, err, test.name+" failed: "+fixture+" was not recorded!")
+		}
+	}
+
+	files, _ := ioutil.ReadDir(dir)
+	assert.Len(t, files, 3)
+	gtg, _ := replaying.Checker()
+	assert.Equal(t, "Public Concepts API is healthy", gtg)
+}
+
+func TestRecordAndReplayFixturesByRelationships(t *testing.T) {
+	uuid := graphQLSubsidiary2UUID
+	responses := graphQLResponses()
+	responses["/concepts/"+uuid+"?showRelationship=related&showRelationship=broader&showRelationship=narrower"] = mockResponse{statusCode: 200, body: getOrganisationWithBroaderAndNarrower}
+
+	dir := t.TempDir()
+	recorder, err := NewRecordingClient(&mockRoutingHTTPClient{responses: responses}, dir)
+	assert.NoError(t, err)
+	recording := NewHandler(recorder, "")
+	replaying := NewHandler(NewFixtureClient(dir), "")
+	hierarchy := requestOptions{broader: true, narrower: true}
+
+	recordedHierarchy, _, err := recording.getOrganisationViaConceptsAPI(context.Background(), uuid, "tid_test", hierarchy)
+	assert.NoError(t, err)
+	recordedDefault, _, err := recording.getOrganisationViaConceptsAPI(context.Background(), uuid, "tid_test", requestOptions{})
+	assert.NoError(t, err)
+
+	replayedHierarchy, _, err := replaying.getOrganisationViaConceptsAPI(context.Background(), uuid, "tid_test", hierarchy)
+	assert.NoError(t, err)
+	replayedDefault, _, err := replaying.getOrganisationViaConceptsAPI(context.Background(), uuid, "tid_test", requestOptions{})
+	assert.NoError(t, err)
+
+	assert.NotEmpty(t, replayedHierarchy.BroaderConcepts, "the organisation requested with broader concepts should keep them")
+	assert.Equal(t, recordedHierarchy, replayedHierarchy, "organisations requested with broader concepts do not match!")
+	assert.Equal(t, recordedDefault, replayedDefault, "organisations requested by default do not match!")
+	for _, fixture := range []string{uuid + ".json", uuid + "+broader+narrower.json"} {
+		_, err := os.Stat(filepath.Join(dir, fixture))
+		assert.NoError(t, err, fixture+" was not recorded!")
+	}
+}
+
+func TestRecordingSurvivesUnwritableFixtures(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "fixtures")
+	recorder, err := NewRecordingClient(&mockRoutingHTTPClient{responses: graphQLResponses()}, dir)
+	assert.NoError(t, err)
+	assert.NoError(t, os.RemoveAll(dir))
+	assert.NoError(t, ioutil.WriteFile(dir, []byte{}, 0644))
+
+	bh := NewHandler(recorder, "")
+	organisation, found, err := bh.FetchOrganisation(graphQLParentUUID, "tid_test")
+
+	assert.NoError(t, err, "failing to record a fixture should not fail the request!")
+	assert.True(t, found, "failing to record a fixture should not fail the request!")
+	assert.Equal(t, "Nintendo Holdings", organisation.PrefLabel, "failing to record a fixture should not fail the request!")
+}
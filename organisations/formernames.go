@@ -0,0 +1,69 @@
+package organisations
+
+import (
+	"sort"
+	"time"
+)
+
+// nameAtLayout is the date format of the nameAt query parameter
+const nameAtLayout = "2006-01-02"
+
+// FormerName is a former name of an organisation with the period it was used in, when public-concepts-api knows it.
+// ValidFrom is the first day the name was used and ValidTo the day it was replaced.
+type FormerName struct {
+	Name      string `json:"name"`
+	ValidFrom string `json:"validFrom,omitempty"`
+	ValidTo   string `json:"validTo,omitempty"`
+}
+
+// sortFormerNames orders former names chronologically, undated names last in upstream order
+func sortFormerNames(names []FormerName) {
+	sort.SliceStable(names, func(i, j int) bool {
+		a, b := names[i].sortDate(), names[j].sortDate()
+		if a.IsZero() || b.IsZero() {
+			return !a.IsZero() && b.IsZero()
+		}
+		return a.Before(b)
+	})
+}
+
+// sortDate is the date ordering a former name, the start of its period or else its end
+func (n FormerName) sortDate() time.Time {
+	if from := parseLabelDate(n.ValidFrom); !from.IsZero() {
+		return from
+	}
+	return parseLabelDate(n.ValidTo)
+}
+
+// validOn tells whether the name was in use on the date. Names without any dates are never known to be valid.
+func (n FormerName) validOn(date time.Time) bool {
+	from, to := parseLabelDate(n.ValidFrom), parseLabelDate(n.ValidTo)
+	if from.IsZero() && to.IsZero() {
+		return false
+	}
+	return (from.IsZero() || !date.Before(from)) && (to.IsZero() || date.Before(to))
+}
+
+// prefLabelAt returns the name of the organisation on the date: the former name valid then, or else the current prefLabel
+func prefLabelAt(organisation Organisation, date time.Time) string {
+	for _, name := range organisation.FormerNameHistory {
+		if name.validOn(date) {
+			return name.Name
+		}
+	}
+	return organisation.PrefLabel
+}
+
+// parseLabelDate reads the date qualifiers of labels, which are dates or timestamps; anything else is treated as no date
+func parseLabelDate(value string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+	if date, err := time.Parse(nameAtLayout, value); err == nil {
+		return date
+	}
+	if timestamp, err := time.Parse(time.RFC3339, value); err == nil {
+		return timestamp.UTC().Truncate(24 * time.Hour)
+	}
+	return time.Time{}
+}
@@ -0,0 +1,52 @@
+package organisations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortFormerNames(t *testing.T) {
+	names := []FormerName{
+		{Name: "Undated"},
+		{Name: "Google Inc", ValidFrom: "1998-09-04", ValidTo: "2015-10-02"},
+		{Name: "BackRub", ValidTo: "1997-09-15"},
+		{Name: "Google", ValidFrom: "1997-09-15T00:00:00Z", ValidTo: "1998-09-04"},
+	}
+	sortFormerNames(names)
+
+	order := []string{}
+	for _, name := range names {
+		order = append(order, name.Name)
+	}
+	assert.Equal(t, []string{"BackRub", "Google", "Google Inc", "Undated"}, order)
+}
+
+func TestPrefLabelAt(t *testing.T) {
+	org := Organisation{
+		Thing: Thing{PrefLabel: "Alphabet Inc"},
+		FormerNameHistory: []FormerName{
+			{Name: "BackRub", ValidTo: "1997-09-15"},
+			{Name: "Google Inc", ValidFrom: "1998-09-04", ValidTo: "2015-10-02"},
+			{Name: "Undated"},
+		},
+	}
+
+	testCases := []struct {
+		date     string
+		expected string
+	}{
+		{"1996-01-01", "BackRub"},
+		{"1997-09-15", "Alphabet Inc"},
+		{"1998-09-04", "Google Inc"},
+		{"2015-10-01", "Google Inc"},
+		{"2015-10-02", "Alphabet Inc"},
+		{"2020-01-01", "Alphabet Inc"},
+	}
+
+	for _, test := range testCases {
+		date, _ := time.Parse(nameAtLayout, test.date)
+		assert.Equal(t, test.expected, prefLabelAt(org, date), test.date+" failed: labels do not match!")
+	}
+}
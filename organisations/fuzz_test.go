@@ -0,0 +1,90 @@
+package organisations
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/gorilla/mux"
+)
+
+const fuzzUUID = "d6b12f0c-bf3f-4045-a07b-1e4e49103fd6"
+
+var organisationPath = regexp.MustCompile("^/organisations/" + validUUID)
+
+// conceptSeeds are public-concepts-api responses the fuzz targets start from
+func conceptSeeds() []string {
+	return []string{
+		getBasicOrganisationAsConcept,
+		getPersonAsConcept,
+		getCompleteOrganisationAsConcept,
+		getCompleteDeprecatedOrganisationAsConcept,
+		getOrganisationWithSources,
+		getOrganisationWithBroaderAndNarrower,
+		getOrganisationWithOtherPredicates,
+		getOrganisationWithSeveralParents,
+		getDeprecatedOrganisationWithSuccessor,
+		getDatedFormerNameOrganisationAsConcept,
+		getLocalisedOrganisationAsConcept,
+		`{"id": "http://www.ft.com/thing/7c5218a0-3755-463e-abbc-1a1632cfd1da", "type": "http://www.ft.com/ontology/organisation/Organisation"}`,
+		`{"id": "", "type": "http://www.ft.com/ontology/organisation/Organisation", "relatedConcepts": [{"concept": {}, "predicate": ""}]}`,
+		`{}`,
+	}
+}
+
+// FuzzConceptMapping maps arbitrary public-concepts-api responses, which should never panic, and should always give
+// organisations that can be written as JSON in either schema version
+func FuzzConceptMapping(f *testing.F) {
+	for _, seed := range conceptSeeds() {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, body string) {
+		h := NewHandler(&mockHTTPClient{resp: body, statusCode: 200}, "")
+		organisation, found, err := h.getOrganisationViaConceptsAPI(context.Background(), fuzzUUID, "tid_fuzz", requestOptions{broader: true, narrower: true})
+		if err != nil || !found {
+			return
+		}
+		for _, version := range []schemaVersion{defaultVersion, {version: 2, mediaType: v2MediaType}} {
+			out, err := json.Marshal(version.shape(organisation))
+			if err != nil || !json.Valid(out) {
+				t.Fatalf("organisation mapped from %q cannot be written as JSON in v%d: %v", body, version.version, err)
+			}
+		}
+	})
+}
+
+// FuzzGetOrganisation requests an organisation with arbitrary query strings and public-concepts-api responses, checking
+// that responses other than redirects are valid JSON and that redirects point at a valid organisation path
+func FuzzGetOrganisation(f *testing.F) {
+	for _, seed := range conceptSeeds() {
+		f.Add(seed, "")
+		f.Add(seed, "resolveAliases=true&include=broader,narrower&nameAt=2010-01-01&expand=country")
+	}
+	f.Add(getBasicOrganisationAsConcept, `nameAt=2010"-01-01`)
+	f.Add(getBasicOrganisationAsConcept, `include=broader\"`)
+	f.Fuzz(func(t *testing.T, body string, query string) {
+		router := mux.NewRouter()
+		h := NewHandler(&mockHTTPClient{resp: body, statusCode: 200}, "")
+		h.RedirectDeprecated(true)
+		h.RegisterHandlers(router)
+
+		req := httptest.NewRequest("GET", "/organisations/"+fuzzUUID, nil)
+		req.URL.RawQuery = query
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		if location := rec.Header().Get("Location"); location != "" {
+			redirect, err := url.Parse(location)
+			if err != nil || !organisationPath.MatchString(redirect.Path) {
+				t.Fatalf("redirected to %q for %q with ?%s", location, body, query)
+			}
+			return
+		}
+		if !json.Valid(rec.Body.Bytes()) {
+			t.Fatalf("responded with invalid JSON %q for %q with ?%s", rec.Body.String(), body, query)
+		}
+	})
+}
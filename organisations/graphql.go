@@ -0,0 +1,453 @@
+package organisations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	logger "github.com/Financial-Times/go-logger"
+	transactionidutils "github.com/Financial-Times/transactionid-utils-go"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// Default limits of GraphQL queries
+const (
+	DefaultGraphQLMaxDepth      = 8
+	DefaultGraphQLMaxComplexity = 250
+	DefaultGraphQLMaxFetches    = 100
+)
+
+const (
+	// organisationFieldCost is the complexity of a field resolving organisations, as it may need upstream requests
+	organisationFieldCost = 10
+	// graphQLFetchConcurrency bounds the upstream requests made at once for one batch of organisations
+	graphQLFetchConcurrency = 8
+)
+
+// GraphQLLimits bound the queries accepted by the GraphQL endpoint.
+// Depth is the deepest nesting of fields; complexity counts every selected field once and fields resolving organisations ten times.
+// As lists of parents and subsidiaries can be of any length, fetches bound the organisations a query loads while it is executed.
+type GraphQLLimits struct {
+	MaxDepth      int
+	MaxComplexity int
+	MaxFetches    int
+}
+
+type loaderContextKey struct{}
+
+// graphQLRequest is the body of a GraphQL POST request
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// GraphQL answers GraphQL queries over organisations and their parents, subsidiaries and financial instruments,
+// sent as a JSON POST body or as the query parameter of a GET request
+func (h *OrganisationsHandler) GraphQL(w http.ResponseWriter, r *http.Request) {
+	transID := transactionidutils.GetTransactionIDFromRequest(r)
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	request := graphQLRequest{Query: r.URL.Query().Get("query"), OperationName: r.URL.Query().Get("operationName")}
+	if r.Method == http.MethodPost {
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			writeGraphQLErrors(w, http.StatusBadRequest, fmt.Errorf("request body is not a GraphQL request: %v", err))
+			return
+		}
+	}
+	if request.Query == "" {
+		writeGraphQLErrors(w, http.StatusBadRequest, fmt.Errorf("query is missing"))
+		return
+	}
+
+	limits := h.queryLimits()
+	if err := checkGraphQLLimits(request.Query, limits); err != nil {
+		logger.WithTransactionID(transID).WithError(err).Warn("GraphQL query rejected")
+		writeGraphQLErrors(w, http.StatusBadRequest, err)
+		return
+	}
+
+	schema, err := graphQLSchema()
+	if err != nil {
+		logger.WithTransactionID(transID).WithError(err).Error("GraphQL schema is invalid")
+		writeGraphQLErrors(w, http.StatusInternalServerError, fmt.Errorf("GraphQL schema is invalid"))
+		return
+	}
+
+	loader := newOrganisationLoader(h, transID, limits.MaxFetches)
+	result := graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  request.Query,
+		OperationName:  request.OperationName,
+		VariableValues: request.Variables,
+		Context:        context.WithValue(r.Context(), loaderContextKey{}, loader),
+	})
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
+
+func writeGraphQLErrors(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(graphql.Result{Errors: []gqlerrors.FormattedError{gqlerrors.NewFormattedError(err.Error())}})
+}
+
+func (h *OrganisationsHandler) queryLimits() GraphQLLimits {
+	limits := h.graphQLLimits
+	if limits.MaxDepth <= 0 {
+		limits.MaxDepth = DefaultGraphQLMaxDepth
+	}
+	if limits.MaxComplexity <= 0 {
+		limits.MaxComplexity = DefaultGraphQLMaxComplexity
+	}
+	if limits.MaxFetches <= 0 {
+		limits.MaxFetches = DefaultGraphQLMaxFetches
+	}
+	return limits
+}
+
+var (
+	graphQLSchemaOnce  sync.Once
+	builtGraphQLSchema graphql.Schema
+	graphQLSchemaErr   error
+)
+
+// graphQLSchema builds the schema once. It is shared by every handler, as organisation nodes are resolved through the per request loader.
+func graphQLSchema() (graphql.Schema, error) {
+	graphQLSchemaOnce.Do(func() {
+		builtGraphQLSchema, graphQLSchemaErr = newGraphQLSchema()
+	})
+	return builtGraphQLSchema, graphQLSchemaErr
+}
+
+func newGraphQLSchema() (graphql.Schema, error) {
+	stringList := graphql.NewList(graphql.String)
+
+	financialInstrumentType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "FinancialInstrument",
+		Fields: graphql.Fields{
+			"id":         &graphql.Field{Type: graphql.String, Resolve: resolveThingField},
+			"apiUrl":     &graphql.Field{Type: graphql.String, Resolve: resolveThingField},
+			"prefLabel":  &graphql.Field{Type: graphql.String, Resolve: resolveThingField},
+			"types":      &graphql.Field{Type: stringList},
+			"directType": &graphql.Field{Type: graphql.String},
+			"figi":       &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	organisationType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Organisation",
+		Fields: graphql.Fields{
+			"id":                     &graphql.Field{Type: graphql.String, Resolve: resolveThingField},
+			"apiUrl":                 &graphql.Field{Type: graphql.String, Resolve: resolveThingField},
+			"prefLabel":              &graphql.Field{Type: graphql.String, Resolve: resolveThingField},
+			"properName":             &graphql.Field{Type: graphql.String},
+			"shortName":              &graphql.Field{Type: graphql.String},
+			"hiddenLabel":            &graphql.Field{Type: graphql.String},
+			"formerNames":            &graphql.Field{Type: stringList},
+			"countryCode":            &graphql.Field{Type: graphql.String},
+			"countryOfIncorporation": &graphql.Field{Type: graphql.String},
+			"postalCode":             &graphql.Field{Type: graphql.String},
+			"yearFounded":            &graphql.Field{Type: graphql.Int},
+			"types":                  &graphql.Field{Type: stringList},
+			"directType":             &graphql.Field{Type: graphql.String},
+			"labels":                 &graphql.Field{Type: stringList},
+			"aliases":                &graphql.Field{Type: stringList},
+			"leiCode":                &graphql.Field{Type: graphql.String},
+			"isDeprecated":           &graphql.Field{Type: graphql.Boolean},
+			"financialInstrument":    &graphql.Field{Type: financialInstrumentType},
+			"financialInstruments":   &graphql.Field{Type: graphql.NewList(financialInstrumentType)},
+		},
+	})
+	organisationType.AddFieldConfig("parentOrganisation", &graphql.Field{
+		Type: organisationType,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			org, _ := p.Source.(Organisation)
+			if org.Parent == nil {
+				return nil, nil
+			}
+			return loaderFrom(p.Context).load(uuidMatcher.FindString(org.Parent.ID)), nil
+		},
+	})
+	organisationType.AddFieldConfig("parentOrganisations", &graphql.Field{
+		Type: graphql.NewList(organisationType),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			org, _ := p.Source.(Organisation)
+			uuids := []string{}
+			for _, parent := range org.ParentOrganisations {
+				uuids = append(uuids, uuidMatcher.FindString(parent.ID))
+			}
+			return loaderFrom(p.Context).loadMany(uuids), nil
+		},
+	})
+	organisationType.AddFieldConfig("subsidiaries", &graphql.Field{
+		Type: graphql.NewList(organisationType),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			org, _ := p.Source.(Organisation)
+			uuids := []string{}
+			for _, subsidiary := range org.Subsidiaries {
+				uuids = append(uuids, uuidMatcher.FindString(subsidiary.ID))
+			}
+			return loaderFrom(p.Context).loadMany(uuids), nil
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"organisation": &graphql.Field{
+				Type: organisationType,
+				Args: graphql.FieldConfigArgument{
+					"uuid": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					uuid, _ := p.Args["uuid"].(string)
+					if !isUUID(uuid) {
+						return nil, fmt.Errorf("uuid '%s' is invalid", uuid)
+					}
+					return loaderFrom(p.Context).load(uuid), nil
+				},
+			},
+		},
+	})
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+// resolveThingField resolves the fields of the embedded Thing, which the default resolver does not look into
+func resolveThingField(p graphql.ResolveParams) (interface{}, error) {
+	var thing Thing
+	switch source := p.Source.(type) {
+	case Organisation:
+		thing = source.Thing
+	case FinancialInstrument:
+		thing = source.Thing
+	}
+	switch p.Info.FieldName {
+	case "id":
+		return thing.ID, nil
+	case "apiUrl":
+		return thing.APIURL, nil
+	default:
+		return thing.PrefLabel, nil
+	}
+}
+
+func loaderFrom(ctx context.Context) *organisationLoader {
+	return ctx.Value(loaderContextKey{}).(*organisationLoader)
+}
+
+// organisationLoader batches the organisations requested while one level of a query is resolved, and fetches each
+// of them once per request with the handler's fetcher. Resolvers return thunks, which the executor calls once the
+// whole level has been resolved; the first thunk called fetches every organisation requested so far. Once a batch would
+// take the organisations fetched past the maximum, its organisations resolve to an error instead of being fetched.
+type organisationLoader struct {
+	h          *OrganisationsHandler
+	transID    string
+	maxFetches int
+	// opts are the options every organisation is fetched with
+	opts requestOptions
+
+	mu      sync.Mutex
+	pending []string
+	results map[string]loadResult
+	fetches int
+}
+
+// fetchLimitError stops a query that would fetch more organisations than the maximum
+type fetchLimitError struct {
+	maxFetches int
+}
+
+func (e fetchLimitError) Error() string {
+	return fmt.Sprintf("query fetches more than the maximum of %d organisations", e.maxFetches)
+}
+
+// graphQLFetchError is the error a query gets for an organisation that could not be loaded. Failures to fetch are told
+// with the same messages as the REST API, so that the details of public-concepts-api are only logged.
+func graphQLFetchError(err error) error {
+	var limit fetchLimitError
+	if errors.As(err, &limit) {
+		return err
+	}
+	return errors.New(fetchErrorMessage(err))
+}
+
+type loadResult struct {
+	organisation Organisation
+	found        bool
+	err          error
+}
+
+func newOrganisationLoader(h *OrganisationsHandler, transID string, maxFetches int) *organisationLoader {
+	return &organisationLoader{h: h, transID: transID, maxFetches: maxFetches, results: map[string]loadResult{}}
+}
+
+// load returns a thunk resolving to the organisation, or to nil if it is not found
+func (l *organisationLoader) load(uuid string) func() (interface{}, error) {
+	l.enqueue(uuid)
+	return func() (interface{}, error) {
+		result := l.get(uuid)
+		if result.err != nil {
+			return nil, graphQLFetchError(result.err)
+		}
+		if !result.found {
+			return nil, nil
+		}
+		return result.organisation, nil
+	}
+}
+
+// loadMany returns a thunk resolving to the organisations that are found, in order
+func (l *organisationLoader) loadMany(uuids []string) func() (interface{}, error) {
+	for _, uuid := range uuids {
+		l.enqueue(uuid)
+	}
+	return func() (interface{}, error) {
+		organisations := []interface{}{}
+		for _, uuid := range uuids {
+			result := l.get(uuid)
+			if result.err != nil {
+				return nil, graphQLFetchError(result.err)
+			}
+			if result.found {
+				organisations = append(organisations, result.organisation)
+			}
+		}
+		return organisations, nil
+	}
+}
+
+func (l *organisationLoader) enqueue(uuid string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, done := l.results[uuid]; !done && uuid != "" {
+		l.pending = append(l.pending, uuid)
+	}
+}
+
+func (l *organisationLoader) get(uuid string) loadResult {
+	l.dispatch()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.results[uuid]
+}
+
+// dispatch fetches the pending organisations, with bounded concurrency
+func (l *organisationLoader) dispatch() {
+	l.mu.Lock()
+	batch := map[string]bool{}
+	for _, uuid := range l.pending {
+		if _, done := l.results[uuid]; !done {
+			batch[uuid] = true
+		}
+	}
+	l.pending = nil
+	if l.fetches+len(batch) > l.maxFetches {
+		err := fetchLimitError{l.maxFetches}
+		for uuid := range batch {
+			l.results[uuid] = loadResult{err: err}
+		}
+		l.mu.Unlock()
+		logger.WithTransactionID(l.transID).WithError(err).Warn("GraphQL query stopped")
+		return
+	}
+	l.fetches += len(batch)
+	l.mu.Unlock()
+	if len(batch) == 0 {
+		return
+	}
+
+	wg := sync.WaitGroup{}
+	slots := make(chan struct{}, graphQLFetchConcurrency)
+	for uuid := range batch {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(uuid string) {
+			defer func() { <-slots; wg.Done() }()
+			organisation, found, err := l.h.getOrganisation(uuid, l.transID, l.opts)
+			l.mu.Lock()
+			l.results[uuid] = loadResult{organisation: organisation, found: found, err: err}
+			l.mu.Unlock()
+		}(uuid)
+	}
+	wg.Wait()
+}
+
+// checkGraphQLLimits rejects queries nesting fields deeper, or selecting more fields, than the limits allow.
+// Queries that cannot be parsed are left for the executor to report.
+func checkGraphQLLimits(query string, limits GraphQLLimits) error {
+	document, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{Body: []byte(query)})})
+	if err != nil {
+		return nil
+	}
+	fragments := map[string]*ast.FragmentDefinition{}
+	for _, definition := range document.Definitions {
+		if fragment, ok := definition.(*ast.FragmentDefinition); ok {
+			fragments[fragment.Name.Value] = fragment
+		}
+	}
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		depth, complexity := measureSelections(operation.SelectionSet, fragments, map[string]bool{})
+		if depth > limits.MaxDepth {
+			return fmt.Errorf("query depth %d exceeds the maximum of %d", depth, limits.MaxDepth)
+		}
+		if complexity > limits.MaxComplexity {
+			return fmt.Errorf("query complexity %d exceeds the maximum of %d", complexity, limits.MaxComplexity)
+		}
+	}
+	return nil
+}
+
+// measureSelections returns the depth and complexity of a selection set, expanding fragments that are not already being expanded
+func measureSelections(selections *ast.SelectionSet, fragments map[string]*ast.FragmentDefinition, expanding map[string]bool) (int, int) {
+	if selections == nil {
+		return 0, 0
+	}
+	depth, complexity := 0, 0
+	for _, selection := range selections.Selections {
+		var d, c int
+		switch s := selection.(type) {
+		case *ast.Field:
+			d, c = measureSelections(s.SelectionSet, fragments, expanding)
+			d++
+			if isOrganisationField(s.Name.Value) {
+				c += organisationFieldCost
+			} else {
+				c++
+			}
+		case *ast.InlineFragment:
+			d, c = measureSelections(s.SelectionSet, fragments, expanding)
+		case *ast.FragmentSpread:
+			fragment, found := fragments[s.Name.Value]
+			if !found || expanding[s.Name.Value] {
+				continue
+			}
+			expanding[s.Name.Value] = true
+			d, c = measureSelections(fragment.SelectionSet, fragments, expanding)
+			delete(expanding, s.Name.Value)
+		}
+		if d > depth {
+			depth = d
+		}
+		complexity += c
+	}
+	return depth, complexity
+}
+
+func isOrganisationField(name string) bool {
+	switch name {
+	case "organisation", "parentOrganisation", "parentOrganisations", "subsidiaries":
+		return true
+	}
+	return false
+}
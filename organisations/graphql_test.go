@@ -0,0 +1,178 @@
+package organisations
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	graphQLOrganisationUUID = "7c5218a0-3755-463e-abbc-1a1632cfd1da"
+	graphQLParentUUID       = "335e9e5a-8f2e-11e8-8f42-da24cd01f044"
+	graphQLSubsidiaryUUID   = "1b070fbb-6331-3225-bb57-9108deb67df4"
+	graphQLSubsidiary2UUID  = "d6b12f0c-bf3f-4045-a07b-1e4e49103fd6"
+)
+
+func TestGraphQL(t *testing.T) {
+	testCases := []struct {
+		name             string
+		method           string
+		body             string
+		expectedCode     int
+		expectedBody     string
+		expectedRequests int
+	}{
+		{
+			"Nested organisations",
+			"POST",
+			`{"query": "{ organisation(uuid: \"` + graphQLOrganisationUUID + `\") { prefLabel parentOrganisation { prefLabel } subsidiaries { prefLabel parentOrganisation { prefLabel } } financialInstrument { figi } } }"}`,
+			200,
+			`{"data":{"organisation":{"financialInstrument":{"figi":"BBG000BLCPP4"},"parentOrganisation":{"prefLabel":"Nintendo Holdings"},"prefLabel":"Nintendo Co Ltd","subsidiaries":[{"parentOrganisation":{"prefLabel":"Nintendo Co Ltd"},"prefLabel":"Nintendo France SARL"},{"parentOrganisation":{"prefLabel":"Nintendo Co Ltd"},"prefLabel":"Nintendo of America"}]}}}`,
+			4,
+		},
+		{
+			"Variables",
+			"POST",
+			`{"query": "query Org($uuid: String!) { organisation(uuid: $uuid) { id } }", "variables": {"uuid": "` + graphQLParentUUID + `"}}`,
+			200,
+			`{"data":{"organisation":{"id":"http://api.ft.com/things/` + graphQLParentUUID + `"}}}`,
+			1,
+		},
+		{
+			"Not found",
+			"POST",
+			`{"query": "{ organisation(uuid: \"00000000-0000-0000-0000-000000000000\") { id } }"}`,
+			200,
+			`{"data":{"organisation":null}}`,
+			1,
+		},
+		{
+			"Too deep",
+			"POST",
+			`{"query": "{ organisation(uuid: \"` + graphQLOrganisationUUID + `\") { subsidiaries { parentOrganisation { subsidiaries { parentOrganisation { id } } } } } }"}`,
+			400,
+			`{"data":null,"errors":[{"message":"query depth 6 exceeds the maximum of 4","locations":[]}]}`,
+			0,
+		},
+		{
+			"Missing query",
+			"POST",
+			`{}`,
+			400,
+			`{"data":null,"errors":[{"message":"query is missing","locations":[]}]}`,
+			0,
+		},
+	}
+
+	for _, test := range testCases {
+		mockClient := &mockRoutingHTTPClient{responses: graphQLResponses()}
+		router := mux.NewRouter()
+		bh := NewHandler(mockClient, "")
+		bh.UseGraphQLLimits(GraphQLLimits{MaxDepth: 4})
+		bh.RegisterHandlers(router)
+
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest(test.method, "/graphql", strings.NewReader(test.body))
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, test.expectedCode, rec.Code, test.name+" failed: status codes do not match!")
+		assert.Equal(t, test.expectedBody+"\n", rec.Body.String(), test.name+" failed: bodies do not match!")
+		assert.Len(t, mockClient.requests, test.expectedRequests, test.name+" failed: upstream requests do not match!")
+	}
+}
+
+func TestGraphQLFetchesEachOrganisationOnce(t *testing.T) {
+	mockClient := &mockRoutingHTTPClient{responses: graphQLResponses()}
+	router := mux.NewRouter()
+	bh := NewHandler(mockClient, "")
+	bh.RegisterHandlers(router)
+
+	query := `{ organisation(uuid: "` + graphQLOrganisationUUID + `") { subsidiaries { parentOrganisation { subsidiaries { id } } } parentOrganisations { id } } }`
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/graphql?query="+strings.Replace(query, " ", "+", -1), nil)
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, 200, rec.Code)
+	sort.Strings(mockClient.requests)
+	assert.Equal(t, []string{
+		"/concepts/" + graphQLSubsidiaryUUID + "?showRelationship=related",
+		"/concepts/" + graphQLParentUUID + "?showRelationship=related",
+		"/concepts/" + graphQLOrganisationUUID + "?showRelationship=related",
+		"/concepts/" + graphQLSubsidiary2UUID + "?showRelationship=related",
+	}, mockClient.requests)
+}
+
+func TestCheckGraphQLLimits(t *testing.T) {
+	testCases := []struct {
+		name          string
+		query         string
+		expectedError string
+	}{
+		{"Within limits", `{ organisation(uuid: "x") { id parentOrganisation { id } } }`, ""},
+		{"Depth", `{ organisation(uuid: "x") { parentOrganisation { parentOrganisation { parentOrganisation { id } } } } }`, "query depth 5 exceeds the maximum of 4"},
+		{"Complexity", `{ a: organisation(uuid: "x") { id } b: organisation(uuid: "y") { id } c: organisation(uuid: "z") { id } }`, "query complexity 33 exceeds the maximum of 30"},
+		{"Fragments", `{ organisation(uuid: "x") { ...deep } } fragment deep on Organisation { parentOrganisation { parentOrganisation { parentOrganisation { id } } } }`, "query depth 5 exceeds the maximum of 4"},
+		{"Cyclic fragments", `{ organisation(uuid: "x") { ...a } } fragment a on Organisation { id ...a }`, ""},
+		{"Unparseable", `{ organisation(`, ""},
+	}
+
+	for _, test := range testCases {
+		err := checkGraphQLLimits(test.query, GraphQLLimits{MaxDepth: 4, MaxComplexity: 30})
+		if test.expectedError == "" {
+			assert.NoError(t, err, test.name+" failed: unexpected error")
+		} else {
+			assert.EqualError(t, err, test.expectedError, test.name+" failed: errors do not match!")
+		}
+	}
+}
+
+func graphQLResponses() map[string]mockResponse {
+	concept := func(uuid string, label string, related string) mockResponse {
+		return mockResponse{statusCode: 200, body: fmt.Sprintf(`{
+			"id": "http://www.ft.com/thing/%s",
+			"apiUrl": "http://api.ft.com/concepts/%s",
+			"type": "http://www.ft.com/ontology/organisation/Organisation",
+			"prefLabel": "%s",
+			"relatedConcepts": [%s]
+		}`, uuid, uuid, label, related)}
+	}
+	relation := func(uuid string, predicate string) string {
+		return fmt.Sprintf(`{"concept": {"id": "http://www.ft.com/thing/%s", "apiUrl": "http://api.ft.com/concepts/%s", "type": "http://www.ft.com/ontology/organisation/Organisation"}, "predicate": "http://www.ft.com/ontology/%s"}`, uuid, uuid, predicate)
+	}
+	instrument := `{"concept": {"id": "http://www.ft.com/thing/dfee4b8f-ceee-37ba-ab24-752cf7a9281c", "apiUrl": "http://api.ft.com/concepts/dfee4b8f-ceee-37ba-ab24-752cf7a9281c", "type": "http://www.ft.com/ontology/FinancialInstrument", "figiCode": "BBG000BLCPP4"}, "predicate": "http://www.ft.com/ontology/issued"}`
+
+	return map[string]mockResponse{
+		"/concepts/" + graphQLOrganisationUUID + "?showRelationship=related": concept(graphQLOrganisationUUID, "Nintendo Co Ltd", strings.Join([]string{
+			relation(graphQLParentUUID, "subOrganisationOf"),
+			relation(graphQLSubsidiaryUUID, "parentOrganisationOf"),
+			relation(graphQLSubsidiary2UUID, "parentOrganisationOf"),
+			instrument,
+		}, ",")),
+		"/concepts/" + graphQLParentUUID + "?showRelationship=related":      concept(graphQLParentUUID, "Nintendo Holdings", relation(graphQLOrganisationUUID, "parentOrganisationOf")),
+		"/concepts/" + graphQLSubsidiaryUUID + "?showRelationship=related":  concept(graphQLSubsidiaryUUID, "Nintendo France SARL", relation(graphQLOrganisationUUID, "subOrganisationOf")),
+		"/concepts/" + graphQLSubsidiary2UUID + "?showRelationship=related": concept(graphQLSubsidiary2UUID, "Nintendo of America", relation(graphQLOrganisationUUID, "subOrganisationOf")),
+	}
+}
+
+func TestGraphQLLimitsUpstreamFetches(t *testing.T) {
+	mockClient := &mockRoutingHTTPClient{responses: graphQLResponses()}
+	router := mux.NewRouter()
+	bh := NewHandler(mockClient, "")
+	bh.UseGraphQLLimits(GraphQLLimits{MaxFetches: 2})
+	bh.RegisterHandlers(router)
+
+	query := `{ organisation(uuid: "` + graphQLOrganisationUUID + `") { prefLabel subsidiaries { prefLabel } } }`
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/graphql?query="+strings.Replace(query, " ", "+", -1), nil)
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, `{"data":{"organisation":{"prefLabel":"Nintendo Co Ltd","subsidiaries":null}},"errors":[{"message":"query fetches more than the maximum of 2 organisations","locations":[{"line":1,"column":74}],"path":["organisation","subsidiaries"]}]}`+"\n", rec.Body.String())
+	assert.Len(t, mockClient.requests, 1, "subsidiaries past the limit should not be fetched")
+}
@@ -0,0 +1,277 @@
+package organisations
+
+import (
+	"context"
+
+	logger "github.com/Financial-Times/go-logger"
+	"github.com/Financial-Times/public-organisations-api/v3/organisationspb"
+	transactionidutils "github.com/Financial-Times/transactionid-utils-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// maxBatchSize bounds the UUIDs of one BatchGetOrganisations call
+const maxBatchSize = 100
+
+// RegisterGRPCServices registers the Organisations service, and a health service reporting the same status as /__gtg
+func (h *OrganisationsHandler) RegisterGRPCServices(server *grpc.Server) {
+	organisationspb.RegisterOrganisationsServer(server, &grpcOrganisationsServer{h: h})
+	healthpb.RegisterHealthServer(server, &grpcHealthServer{h: h})
+}
+
+type grpcOrganisationsServer struct {
+	organisationspb.UnimplementedOrganisationsServer
+	h *OrganisationsHandler
+}
+
+// GetOrganisation fetches and maps the organisation as GET /organisations/{uuid} does
+func (s *grpcOrganisationsServer) GetOrganisation(ctx context.Context, req *organisationspb.GetOrganisationRequest) (*organisationspb.GetOrganisationResponse, error) {
+	transID := grpcTransactionID(ctx)
+	if !isUUID(req.GetUuid()) {
+		return nil, status.Errorf(codes.InvalidArgument, "uuid '%s' is either missing or invalid", req.GetUuid())
+	}
+
+	opts := requestOptions{}
+	opts.include(req.GetInclude(), req.GetExpand())
+	organisation, found, err := s.h.getOrganisation(req.GetUuid(), transID, opts)
+	if err != nil {
+		return nil, grpcFetchError(err, "failed to return organisation")
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "organisation %s not found", req.GetUuid())
+	}
+	return &organisationspb.GetOrganisationResponse{Organisation: organisationMessage(organisation)}, nil
+}
+
+// BatchGetOrganisations fetches the organisations concurrently, each once, with the same fetcher as the GraphQL endpoint.
+// Each UUID gets a result with the status GetOrganisation would have returned, so that one UUID does not fail the batch.
+func (s *grpcOrganisationsServer) BatchGetOrganisations(ctx context.Context, req *organisationspb.BatchGetOrganisationsRequest) (*organisationspb.BatchGetOrganisationsResponse, error) {
+	transID := grpcTransactionID(ctx)
+	if len(req.GetUuids()) > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d uuids can be requested at once", maxBatchSize)
+	}
+	loader := newOrganisationLoader(s.h, transID, maxBatchSize)
+	loader.opts.include(req.GetInclude(), req.GetExpand())
+	for _, uuid := range req.GetUuids() {
+		if isUUID(uuid) {
+			loader.enqueue(uuid)
+		}
+	}
+
+	resp := &organisationspb.BatchGetOrganisationsResponse{}
+	seen := map[string]bool{}
+	for _, uuid := range req.GetUuids() {
+		if seen[uuid] {
+			continue
+		}
+		seen[uuid] = true
+		if !isUUID(uuid) {
+			resp.Results = append(resp.Results, organisationResult(uuid, status.Newf(codes.InvalidArgument, "uuid '%s' is either missing or invalid", uuid)))
+			continue
+		}
+		result := loader.get(uuid)
+		switch {
+		case result.err != nil:
+			logger.WithTransactionID(transID).WithError(result.err).Errorf("failed to return organisation %s in batch", uuid)
+			resp.Results = append(resp.Results, organisationResult(uuid, status.Convert(grpcFetchError(result.err, "failed to return organisation"))))
+		case result.found:
+			resp.Results = append(resp.Results, &organisationspb.OrganisationResult{
+				Uuid:         uuid,
+				Code:         int32(codes.OK),
+				Organisation: organisationMessage(result.organisation),
+			})
+		default:
+			resp.Results = append(resp.Results, organisationResult(uuid, status.Newf(codes.NotFound, "organisation %s not found", uuid)))
+		}
+	}
+	return resp, nil
+}
+
+// organisationResult is the result of a UUID of a batch that could not be returned
+func organisationResult(uuid string, st *status.Status) *organisationspb.OrganisationResult {
+	return &organisationspb.OrganisationResult{Uuid: uuid, Code: int32(st.Code()), Message: st.Message()}
+}
+
+// grpcFetchError is Internal when public-concepts-api broke its contract, as retrying will not help, and Unavailable otherwise
+func grpcFetchError(err error, msg string) error {
+	if isContractViolation(err) {
+		return status.Error(codes.Internal, contractViolationMessage)
+	}
+	return status.Error(codes.Unavailable, msg)
+}
+
+// grpcTransactionID reads the transaction ID from the X-Request-Id metadata, or generates one
+func grpcTransactionID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get("x-request-id"); len(ids) > 0 && ids[0] != "" {
+			return ids[0]
+		}
+	}
+	return transactionidutils.NewTransactionID()
+}
+
+type grpcHealthServer struct {
+	healthpb.UnimplementedHealthServer
+	h *OrganisationsHandler
+}
+
+// Check reports the server, or the Organisations service, as serving when public-concepts-api is healthy
+func (s *grpcHealthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if service := req.GetService(); service != "" && service != organisationspb.Organisations_ServiceDesc.ServiceName {
+		return nil, status.Errorf(codes.NotFound, "unknown service %s", service)
+	}
+	if gtg := gtgCheck(s.h.Checker); !gtg.GoodToGo {
+		logger.Warnf("gRPC health check failed: %s", gtg.Message)
+		return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_NOT_SERVING}, nil
+	}
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
+
+// organisationMessage maps an organisation to its protobuf message
+func organisationMessage(organisation Organisation) *organisationspb.Organisation {
+	msg := &organisationspb.Organisation{
+		Id:                     organisation.ID,
+		ApiUrl:                 organisation.APIURL,
+		PrefLabel:              organisation.PrefLabel,
+		ProperName:             organisation.ProperName,
+		ShortName:              organisation.ShortName,
+		HiddenLabel:            organisation.HiddenLabel,
+		FormerNames:            organisation.FormerNames,
+		CountryCode:            organisation.CountryCode,
+		CountryOfIncorporation: organisation.CountryOfIncorporation,
+		PostalCode:             organisation.PostalCode,
+		YearFounded:            int32(organisation.YearFounded),
+		Types:                  organisation.Types,
+		DirectType:             organisation.DirectType,
+		Labels:                 organisation.Labels,
+		Aliases:                organisation.Aliases,
+		LeiCode:                organisation.LegalEntityIdentifier,
+		IsDeprecated:           organisation.IsDeprecated,
+	}
+	if len(organisation.Identifiers) > 0 {
+		msg.Identifiers = map[string]*organisationspb.Identifiers{}
+		for authority, values := range organisation.Identifiers {
+			msg.Identifiers[authority] = &organisationspb.Identifiers{Values: values}
+		}
+	}
+	if organisation.Parent != nil {
+		msg.ParentOrganisation = parentMessage(*organisation.Parent)
+	}
+	for _, parent := range organisation.ParentOrganisations {
+		msg.ParentOrganisations = append(msg.ParentOrganisations, parentMessage(parent))
+	}
+	for _, subsidiary := range organisation.Subsidiaries {
+		msg.Subsidiaries = append(msg.Subsidiaries, &organisationspb.Subsidiary{
+			Id:         subsidiary.ID,
+			ApiUrl:     subsidiary.APIURL,
+			PrefLabel:  subsidiary.PrefLabel,
+			Types:      subsidiary.Types,
+			DirectType: subsidiary.DirectType,
+		})
+	}
+	if organisation.FinancialInstrument != nil {
+		msg.FinancialInstrument = financialInstrumentMessage(*organisation.FinancialInstrument)
+	}
+	for _, instrument := range organisation.FinancialInstruments {
+		msg.FinancialInstruments = append(msg.FinancialInstruments, financialInstrumentMessage(instrument))
+	}
+	for _, label := range organisation.TypedLabels {
+		msg.TypedLabels = append(msg.TypedLabels, &organisationspb.TypedLabel{
+			Type:      label.Type,
+			Value:     label.Value,
+			Language:  label.Language,
+			ValidFrom: label.ValidFrom,
+			ValidTo:   label.ValidTo,
+		})
+	}
+	if organisation.ReplacedBy != nil {
+		msg.ReplacedBy = conceptSummaryMessage(*organisation.ReplacedBy)
+	}
+	for _, name := range organisation.FormerNameHistory {
+		msg.FormerNameHistory = append(msg.FormerNameHistory, &organisationspb.FormerName{
+			Name:      name.Name,
+			ValidFrom: name.ValidFrom,
+			ValidTo:   name.ValidTo,
+		})
+	}
+	if organisation.CountryCodeDetails != nil {
+		msg.CountryCodeDetails = countryMessage(*organisation.CountryCodeDetails)
+	}
+	if organisation.CountryOfIncorporationDetails != nil {
+		msg.CountryOfIncorporationDetails = countryMessage(*organisation.CountryOfIncorporationDetails)
+	}
+	if len(organisation.LocalisedLabels) > 0 {
+		msg.LocalisedLabels = map[string]*organisationspb.Labels{}
+		for language, labels := range organisation.LocalisedLabels {
+			msg.LocalisedLabels[language] = &organisationspb.Labels{Values: labels}
+		}
+	}
+	for _, concept := range organisation.BroaderConcepts {
+		msg.BroaderConcepts = append(msg.BroaderConcepts, conceptSummaryMessage(concept))
+	}
+	for _, concept := range organisation.NarrowerConcepts {
+		msg.NarrowerConcepts = append(msg.NarrowerConcepts, conceptSummaryMessage(concept))
+	}
+	if len(organisation.Related) > 0 {
+		msg.Related = map[string]*organisationspb.ConceptSummaries{}
+		for predicate, concepts := range organisation.Related {
+			summaries := &organisationspb.ConceptSummaries{}
+			for _, concept := range concepts {
+				summaries.Concepts = append(summaries.Concepts, conceptSummaryMessage(concept))
+			}
+			msg.Related[predicate] = summaries
+		}
+	}
+	for _, classification := range organisation.IndustryClassifications {
+		msg.IndustryClassifications = append(msg.IndustryClassifications, &organisationspb.IndustryClassification{
+			Id:     classification.ID,
+			Code:   classification.Code,
+			Label:  classification.Label,
+			Scheme: classification.Scheme,
+		})
+	}
+	return msg
+}
+
+func conceptSummaryMessage(concept ConceptSummary) *organisationspb.ConceptSummary {
+	return &organisationspb.ConceptSummary{
+		Id:         concept.ID,
+		ApiUrl:     concept.APIURL,
+		PrefLabel:  concept.PrefLabel,
+		Types:      concept.Types,
+		DirectType: concept.DirectType,
+	}
+}
+
+func countryMessage(country Country) *organisationspb.Country {
+	return &organisationspb.Country{
+		Code:   country.Code,
+		Alpha3: country.Alpha3,
+		Name:   country.Name,
+		Region: country.Region,
+	}
+}
+
+func parentMessage(parent Parent) *organisationspb.Parent {
+	return &organisationspb.Parent{
+		Id:         parent.ID,
+		ApiUrl:     parent.APIURL,
+		PrefLabel:  parent.PrefLabel,
+		Types:      parent.Types,
+		DirectType: parent.DirectType,
+	}
+}
+
+func financialInstrumentMessage(instrument FinancialInstrument) *organisationspb.FinancialInstrument {
+	return &organisationspb.FinancialInstrument{
+		Id:         instrument.ID,
+		ApiUrl:     instrument.APIURL,
+		PrefLabel:  instrument.PrefLabel,
+		Types:      instrument.Types,
+		DirectType: instrument.DirectType,
+		Figi:       instrument.Figi,
+	}
+}
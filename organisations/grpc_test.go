@@ -0,0 +1,173 @@
+package organisations
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/Financial-Times/public-organisations-api/v3/organisationspb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// dialGRPC serves the handler's gRPC services in memory and returns a connection to them
+func dialGRPC(t *testing.T, h *OrganisationsHandler) *grpc.ClientConn {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	h.RegisterGRPCServices(server)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to dial gRPC server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestGRPCGetOrganisation(t *testing.T) {
+	testCases := []struct {
+		name          string
+		uuid          string
+		expectedCode  codes.Code
+		expectedLabel string
+	}{
+		{"Found", graphQLOrganisationUUID, codes.OK, "Nintendo Co Ltd"},
+		{"Not found", "00000000-0000-0000-0000-000000000000", codes.NotFound, ""},
+		{"Invalid UUID", "1234", codes.InvalidArgument, ""},
+	}
+
+	bh := NewHandler(&mockRoutingHTTPClient{responses: graphQLResponses()}, "")
+	client := organisationspb.NewOrganisationsClient(dialGRPC(t, &bh))
+	for _, test := range testCases {
+		resp, err := client.GetOrganisation(context.Background(), &organisationspb.GetOrganisationRequest{Uuid: test.uuid})
+		assert.Equal(t, test.expectedCode, status.Code(err), test.name+" failed: status codes do not match!")
+		if test.expectedCode != codes.OK {
+			continue
+		}
+		org := resp.GetOrganisation()
+		assert.Equal(t, test.expectedLabel, org.GetPrefLabel(), test.name+" failed: labels do not match!")
+		assert.Equal(t, "http://api.ft.com/things/"+graphQLParentUUID, org.GetParentOrganisation().GetId(), test.name+" failed: parents do not match!")
+		assert.Len(t, org.GetSubsidiaries(), 2, test.name+" failed: subsidiaries do not match!")
+		assert.Equal(t, "BBG000BLCPP4", org.GetFinancialInstrument().GetFigi(), test.name+" failed: financial instruments do not match!")
+	}
+}
+
+func TestGRPCBatchGetOrganisations(t *testing.T) {
+	missing := "00000000-0000-0000-0000-000000000000"
+	failing := "4e484678-cf47-4168-b844-6adb47f8eb58"
+	invalid := "4e484678-cf47-4168-b844-6adb47f8eb59"
+	responses := graphQLResponses()
+	responses["/concepts/"+failing+"?showRelationship=related"] = mockResponse{statusCode: 500}
+	responses["/concepts/"+invalid+"?showRelationship=related"] = mockResponse{statusCode: 200, body: `{"id": "http://www.ft.com/thing/not-a-uuid"}`}
+	mockClient := &mockRoutingHTTPClient{responses: responses}
+	bh := NewHandler(mockClient, "")
+	client := organisationspb.NewOrganisationsClient(dialGRPC(t, &bh))
+
+	resp, err := client.BatchGetOrganisations(context.Background(), &organisationspb.BatchGetOrganisationsRequest{
+		Uuids: []string{graphQLOrganisationUUID, missing, "1234", failing, graphQLParentUUID, invalid, graphQLOrganisationUUID},
+	})
+	assert.NoError(t, err, "one bad uuid should not fail the batch")
+
+	type batchResult struct {
+		uuid  string
+		code  codes.Code
+		label string
+	}
+	expected := []batchResult{
+		{graphQLOrganisationUUID, codes.OK, "Nintendo Co Ltd"},
+		{missing, codes.NotFound, ""},
+		{"1234", codes.InvalidArgument, ""},
+		{failing, codes.Unavailable, ""},
+		{graphQLParentUUID, codes.OK, "Nintendo Holdings"},
+		{invalid, codes.Internal, ""},
+	}
+	actual := []batchResult{}
+	for _, result := range resp.GetResults() {
+		actual = append(actual, batchResult{result.GetUuid(), codes.Code(result.GetCode()), result.GetOrganisation().GetPrefLabel()})
+		if codes.Code(result.GetCode()) != codes.OK {
+			assert.NotEmpty(t, result.GetMessage(), result.GetUuid()+" failed: the result has no message!")
+		}
+	}
+	assert.Equal(t, expected, actual, "batch results do not match!")
+	assert.Equal(t, contractViolationMessage, resp.GetResults()[5].GetMessage())
+	assert.Len(t, mockClient.requests, 5, "each valid uuid should be fetched once")
+
+	_, err = client.BatchGetOrganisations(context.Background(), &organisationspb.BatchGetOrganisationsRequest{Uuids: make([]string, maxBatchSize+1)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPCOrganisationMirrorsJSON(t *testing.T) {
+	summary := ConceptSummary{Thing: Thing{ID: "http://api.ft.com/things/" + graphQLParentUUID, APIURL: "http://api.ft.com/organisations/" + graphQLParentUUID, PrefLabel: "Nintendo Holdings"}}
+	country := &Country{Code: "JP", Alpha3: "JPN", Name: "Japan", Region: "Asia"}
+	org := Organisation{
+		Thing:                         Thing{ID: "http://api.ft.com/things/" + graphQLOrganisationUUID},
+		FormerNameHistory:             []FormerName{{Name: "Nintendo Playing Card Co., Ltd.", ValidTo: "1951-01-01"}},
+		CountryCodeDetails:            country,
+		CountryOfIncorporationDetails: country,
+		LocalisedLabels:               map[string][]string{"ja": {"任天堂"}},
+		BroaderConcepts:               []ConceptSummary{summary},
+		NarrowerConcepts:              []ConceptSummary{summary, summary},
+		Related:                       map[string][]ConceptSummary{"hasBrand": {summary}},
+		IndustryClassifications:       []IndustryClassification{{ID: "http://api.ft.com/things/38ee195d-ebdd-48a9-af4b-c8a322e7b04d", Code: "5112", Label: "Software Publishers", Scheme: "NAICS"}},
+	}
+
+	msg := organisationMessage(org)
+	assert.Equal(t, "1951-01-01", msg.GetFormerNameHistory()[0].GetValidTo(), "former name history does not match!")
+	assert.Equal(t, "JPN", msg.GetCountryCodeDetails().GetAlpha3(), "country code details do not match!")
+	assert.Equal(t, "Asia", msg.GetCountryOfIncorporationDetails().GetRegion(), "country of incorporation details do not match!")
+	assert.Equal(t, []string{"任天堂"}, msg.GetLocalisedLabels()["ja"].GetValues(), "localised labels do not match!")
+	assert.Len(t, msg.GetBroaderConcepts(), 1, "broader concepts do not match!")
+	assert.Len(t, msg.GetNarrowerConcepts(), 2, "narrower concepts do not match!")
+	assert.Equal(t, "Nintendo Holdings", msg.GetRelated()["hasBrand"].GetConcepts()[0].GetPrefLabel(), "related concepts do not match!")
+	assert.Equal(t, "NAICS", msg.GetIndustryClassifications()[0].GetScheme(), "industry classifications do not match!")
+}
+
+func TestGRPCIncludeAndExpand(t *testing.T) {
+	bh := NewHandler(&mockHTTPClient{resp: getCompleteOrganisationAsConcept, statusCode: 200}, "")
+	client := organisationspb.NewOrganisationsClient(dialGRPC(t, &bh))
+
+	resp, err := client.GetOrganisation(context.Background(), &organisationspb.GetOrganisationRequest{Uuid: "7c5218a0-3755-463e-abbc-1a1632cfd1da"})
+	assert.NoError(t, err)
+	assert.Nil(t, resp.GetOrganisation().GetCountryCodeDetails(), "country details should only be added when expanded")
+
+	resp, err = client.GetOrganisation(context.Background(), &organisationspb.GetOrganisationRequest{Uuid: "7c5218a0-3755-463e-abbc-1a1632cfd1da", Expand: []string{"country"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Japan", resp.GetOrganisation().GetCountryCodeDetails().GetName(), "country details do not match!")
+
+	batch, err := client.BatchGetOrganisations(context.Background(), &organisationspb.BatchGetOrganisationsRequest{Uuids: []string{"7c5218a0-3755-463e-abbc-1a1632cfd1da"}, Expand: []string{"country"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Japan", batch.GetResults()[0].GetOrganisation().GetCountryOfIncorporationDetails().GetName(), "batched country details do not match!")
+}
+
+func TestGRPCHealth(t *testing.T) {
+	testCases := []struct {
+		name           string
+		gtgStatus      int
+		service        string
+		expectedStatus healthpb.HealthCheckResponse_ServingStatus
+		expectedCode   codes.Code
+	}{
+		{"Serving", 200, "", healthpb.HealthCheckResponse_SERVING, codes.OK},
+		{"Service serving", 200, "ft.organisations.v1.Organisations", healthpb.HealthCheckResponse_SERVING, codes.OK},
+		{"Not serving", 503, "", healthpb.HealthCheckResponse_NOT_SERVING, codes.OK},
+		{"Unknown service", 200, "ft.people.v1.People", healthpb.HealthCheckResponse_UNKNOWN, codes.NotFound},
+	}
+
+	for _, test := range testCases {
+		bh := NewHandler(&mockRoutingHTTPClient{responses: map[string]mockResponse{"/__gtg": {statusCode: test.gtgStatus}}}, "")
+		client := healthpb.NewHealthClient(dialGRPC(t, &bh))
+
+		resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: test.service})
+		assert.Equal(t, test.expectedCode, status.Code(err), test.name+" failed: status codes do not match!")
+		assert.Equal(t, test.expectedStatus, resp.GetStatus(), test.name+" failed: serving statuses do not match!")
+	}
+}
@@ -1,11 +1,13 @@
 package organisations
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 
 	fthealth "github.com/Financial-Times/go-fthealth/v1_1"
@@ -24,40 +26,96 @@ type HTTPClient interface {
 type OrganisationsHandler struct {
 	client      HTTPClient
 	conceptsURL string
+	cache       *Cache
+	cachePolicy CachePolicy
+	backend     Backend
+	// redirectDeprecated redirects deprecated organisations with a successor to the successor
+	redirectDeprecated bool
+	// preferredParentType picks the primary parent when there are several; the first in upstream order is used if empty
+	preferredParentType string
+	graphQLLimits       GraphQLLimits
+	exportWorkers       int
 }
 
-// OrganisationDriver for cypher queries
-var CacheControlHeader string
-
 const (
 	validUUID           = "([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})$"
 	ontologyPrefix      = "http://www.ft.com/ontology"
 	organisationSuffix  = "/organisation/Organisation"
 	publicCompanySuffix = "/company/PublicCompany"
-	relatedQueryParam   = "?showRelationship=related"
 	isParentPredicate   = "/parentOrganisationOf"
 	hasParentPredicate  = "/subOrganisationOf"
 	issuedPredicate     = "/issued"
 	thingsApiUrl        = "http://api.ft.com/things/"
 	ftThing             = "http://www.ft.com/thing/"
+	maxRedirectHops     = 5
 )
 
 func NewHandler(client HTTPClient, conceptsURL string) OrganisationsHandler {
 	return OrganisationsHandler{
-		client,
-		conceptsURL,
+		client:      client,
+		conceptsURL: conceptsURL,
+		backend:     NewConceptsAPIBackend(client, conceptsURL),
 	}
 }
 
+// UseBackend replaces the public-concepts-api backend organisations are listed from
+func (h *OrganisationsHandler) UseBackend(backend Backend) {
+	h.backend = backend
+}
+
+// UseCachePolicy sets the Cache-Control directives sent with each class of response
+func (h *OrganisationsHandler) UseCachePolicy(policy CachePolicy) {
+	h.cachePolicy = policy
+}
+
+// UsePreferredParentType makes the first parent of the given type, rather than the first parent
+// returned by public-concepts-api, the primary parent of organisations with several parents
+func (h *OrganisationsHandler) UsePreferredParentType(parentType string) {
+	h.preferredParentType = parentType
+}
+
+// RedirectDeprecated makes requests for deprecated organisations that have been replaced redirect to their successor
+func (h *OrganisationsHandler) RedirectDeprecated(redirect bool) {
+	h.redirectDeprecated = redirect
+}
+
+// UseGraphQLLimits bounds the depth, complexity and fetches of GraphQL queries; zero limits are replaced by the defaults
+func (h *OrganisationsHandler) UseGraphQLLimits(limits GraphQLLimits) {
+	h.graphQLLimits = limits
+}
+
+// UseExportConcurrency sets how many organisations an export fetches at once; zero uses DefaultExportConcurrency
+func (h *OrganisationsHandler) UseExportConcurrency(workers int) {
+	h.exportWorkers = workers
+}
+
+// UseCache makes the handler serve organisations from the given cache, populating it on a miss
+func (h *OrganisationsHandler) UseCache(cache *Cache) {
+	h.cache = cache
+}
+
 func (h *OrganisationsHandler) RegisterHandlers(router *mux.Router) {
 	logger.Info("Registering handlers")
 	mh := handlers.MethodHandler{
 		"GET": http.HandlerFunc(h.GetOrganisation),
 	}
 
+	// registered before /organisations/{uuid}, which would otherwise match it
+	router.Handle("/organisations/export", handlers.MethodHandler{"POST": http.HandlerFunc(h.ExportOrganisations)})
+
 	path := "/organisations/{uuid}"
 	router.Handle(path, mh)
 	router.HandleFunc(path, h.MethodNotAllowedHandler)
+
+	lookupPath := "/organisations"
+	router.Handle(lookupPath, handlers.MethodHandler{"GET": http.HandlerFunc(h.GetOrganisationsByIndustryClassification)}).
+		Queries("industryClassification", "{classification}")
+	router.Handle(lookupPath, handlers.MethodHandler{"GET": http.HandlerFunc(h.GetOrganisationByIdentifier)})
+	router.HandleFunc(lookupPath, h.MethodNotAllowedHandler)
+
+	graphQLPath := "/graphql"
+	router.Handle(graphQLPath, handlers.MethodHandler{"GET": http.HandlerFunc(h.GraphQL), "POST": http.HandlerFunc(h.GraphQL)})
+	router.HandleFunc(graphQLPath, h.MethodNotAllowedHandler)
 }
 
 // HealthCheck does something
@@ -124,40 +182,103 @@ func (h *OrganisationsHandler) GetOrganisation(w http.ResponseWriter, r *http.Re
 		msg := fmt.Sprintf(`uuid '%s' is either missing or invalid`, uuid)
 		logger.WithTransactionID(transID).WithUUID(uuid).Error(msg)
 		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte(`{"message": "` + msg + `"}`))
+		w.Write(messageJSON(msg))
 		return
 	}
 
-	organisation, found, err := h.getOrganisationViaConceptsAPI(uuid, transID)
+	opts, err := parseRequestOptions(r)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(`{"message": "failed to return organisation"}`))
+		logger.WithTransactionID(transID).WithUUID(uuid).Error(err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(messageJSON(err.Error()))
+		return
+	}
+	version, acceptable := negotiateVersion(r)
+	w.Header().Add("Vary", "Accept")
+	if !acceptable {
+		w.WriteHeader(http.StatusNotAcceptable)
+		w.Write([]byte(`{"message": "supported media types are ` + v1MediaType + `, ` + v2MediaType + ` and ` + jsonMediaType + `"}`))
+		return
+	}
+
+	organisation, found, err := h.getOrganisation(uuid, transID, opts)
+	if err != nil {
+		writeFetchError(w, err)
 		return
 	}
 	if !found {
+		setCacheControl(w, h.cachePolicy.NotFound)
 		w.WriteHeader(http.StatusNotFound)
 		w.Write([]byte(`{"message": "organisation not found"}`))
 		return
 	}
-	//if the request was not made for the canonical, but an alternate uuid: redirect
+	//if the request was not made for the canonical, but an alternate uuid: redirect, unless the client asked for aliases to be resolved
+	validRegexp := regexp.MustCompile(validUUID)
+	canonicalUUID := validRegexp.FindString(organisation.ID)
+	if canonicalUUID == "" {
+		// redirecting or linking to the organisation needs its uuid
+		logger.WithTransactionID(transID).WithUUID(uuid).Errorf("organisation id '%s' has no uuid", organisation.ID)
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"message": "failed to return organisation"}`))
+		return
+	}
 	if !strings.Contains(organisation.ID, uuid) {
-		validRegexp := regexp.MustCompile(validUUID)
-		canonicalUUID := validRegexp.FindString(organisation.ID)
-		redirectURL := strings.Replace(r.RequestURI, uuid, canonicalUUID, 1)
-		w.Header().Set("Location", redirectURL)
+		if !resolveAliases(r) {
+			redirectURL := strings.Replace(r.URL.RequestURI(), uuid, canonicalUUID, 1)
+			w.Header().Set("Location", redirectURL)
+			w.Header().Set(surrogateKeyHeader, canonicalUUID+" "+uuid)
+			setCacheControl(w, h.cachePolicy.Redirect)
+			w.WriteHeader(http.StatusMovedPermanently)
+			return
+		}
+		w.Header().Set("Content-Location", strings.Replace(r.URL.Path, uuid, canonicalUUID, 1))
+	}
+
+	if successorUUID := successorUUID(organisation); h.redirectDeprecated && successorUUID != "" {
+		w.Header().Set("Location", strings.Replace(r.URL.RequestURI(), uuid, successorUUID, 1))
+		w.Header().Set(surrogateKeyHeader, successorUUID+" "+canonicalUUID)
+		setDeprecationHeaders(w, successorUUID)
+		setCacheControl(w, h.cachePolicy.Redirect)
 		w.WriteHeader(http.StatusMovedPermanently)
 		return
 	}
 
-	w.Header().Set("Cache-Control", CacheControlHeader)
+	if ranges := parseAcceptLanguage(r.Header.Get("Accept-Language")); len(ranges) > 0 {
+		w.Header().Set("Content-Language", localisePrefLabel(&organisation, ranges))
+	}
+	w.Header().Add("Vary", "Accept-Language")
+	h.writeOrganisation(w, organisation, canonicalUUID, version)
+}
+
+// writeOrganisation responds with the organisation in the schema version, with the cache headers for its class of response
+func (h *OrganisationsHandler) writeOrganisation(w http.ResponseWriter, organisation Organisation, canonicalUUID string, version schemaVersion) {
+	if organisation.IsDeprecated {
+		setCacheControl(w, h.cachePolicy.Deprecated)
+		setDeprecationHeaders(w, successorUUID(organisation))
+	} else {
+		setCacheControl(w, h.cachePolicy.OK)
+	}
+	w.Header().Set(surrogateKeyHeader, surrogateKeys(organisation))
+	w.Header().Set("Content-Type", version.mediaType+"; charset=UTF-8")
 	w.WriteHeader(http.StatusOK)
-	err = json.NewEncoder(w).Encode(organisation)
+	err := json.NewEncoder(w).Encode(version.shape(organisation))
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		w.Write([]byte(`{"message":"Organisation could not be marshelled, err=` + err.Error() + `"}`))
 	}
 }
 
+func resolveAliases(r *http.Request) bool {
+	resolve, _ := strconv.ParseBool(r.URL.Query().Get("resolveAliases"))
+	return resolve
+}
+
+func setCacheControl(w http.ResponseWriter, directives CacheDirectives) {
+	if header := directives.Header(); header != "" {
+		w.Header().Set("Cache-Control", header)
+	}
+}
+
 //GoodToGo returns a 503 if the healthcheck fails - suitable for use from varnish to check availability of a node
 func (h *OrganisationsHandler) GTG() gtg.Status {
 	statusCheck := func() gtg.Status {
@@ -173,44 +294,37 @@ func gtgCheck(handler func() (string, error)) gtg.Status {
 	return gtg.Status{GoodToGo: true}
 }
 
-func (h *OrganisationsHandler) getOrganisationViaConceptsAPI(uuid string, transID string) (organisation Organisation, found bool, err error) {
-	org := Organisation{}
-
-	reqURL := h.conceptsURL + "/concepts/" + uuid + relatedQueryParam
-
-	request, err := http.NewRequest("GET", reqURL, nil)
-
-	if err != nil {
-		msg := fmt.Sprintf("failed to create request to %s", reqURL)
-		logger.WithError(err).WithUUID(uuid).WithTransactionID(transID).Error(msg)
-		return org, false, err
+func (h *OrganisationsHandler) getOrganisation(uuid string, transID string, opts requestOptions) (Organisation, bool, error) {
+	organisation, found, err := h.getCachedOrganisation(uuid, transID, opts)
+	if found && opts.expandCountry {
+		expandCountries(&organisation)
 	}
-
-	request.Header.Set("X-Request-Id", transID)
-	resp, err := h.client.Do(request)
-	if err != nil {
-		msg := fmt.Sprintf("request to %s was unsuccessful", reqURL)
-		logger.WithError(err).WithUUID(uuid).WithTransactionID(transID).Error(msg)
-		return org, false, err
+	if found && !opts.nameAt.IsZero() {
+		organisation.PrefLabelAtDate = prefLabelAt(organisation, opts.nameAt)
 	}
-	defer resp.Body.Close()
+	return organisation, found, err
+}
 
-	if resp.StatusCode == http.StatusNotFound {
-		return org, false, nil
+func (h *OrganisationsHandler) getCachedOrganisation(uuid string, transID string, opts requestOptions) (Organisation, bool, error) {
+	if h.cache != nil {
+		if organisation, found := h.cache.Get(opts.cacheKey(uuid)); found {
+			return organisation, true, nil
+		}
 	}
 
-	conceptsApiResponse := ConceptApiResponse{}
-	body, err := ioutil.ReadAll(resp.Body)
-
-	if err != nil {
-		msg := fmt.Sprintf("failed to read response body: %v", resp.Body)
-		logger.WithError(err).WithUUID(uuid).WithTransactionID(transID).Error(msg)
-		return org, false, err
+	// the organisation may be cached for other requests, so its fetch is not cancelled with the request
+	organisation, found, err := h.getOrganisationViaConceptsAPI(context.Background(), uuid, transID, opts)
+	if err == nil && found && h.cache != nil {
+		h.cache.Set(opts.cacheKey(uuid), organisation)
 	}
+	return organisation, found, err
+}
 
-	if err = json.Unmarshal(body, &conceptsApiResponse); err != nil {
-		msg := fmt.Sprintf("failed to unmarshal response body: %v", body)
-		logger.WithError(err).WithUUID(uuid).WithTransactionID(transID).Error(msg)
+func (h *OrganisationsHandler) getOrganisationViaConceptsAPI(ctx context.Context, uuid string, transID string, opts requestOptions) (organisation Organisation, found bool, err error) {
+	org := Organisation{}
+
+	conceptsApiResponse, redirectedFrom, found, err := h.getConcept(ctx, uuid, transID, opts)
+	if err != nil || !found {
 		return org, false, err
 	}
 
@@ -230,23 +344,32 @@ func (h *OrganisationsHandler) getOrganisationViaConceptsAPI(uuid string, transI
 	org.LegalEntityIdentifier = conceptsApiResponse.LeiCode
 	org.YearFounded = conceptsApiResponse.YearFounded
 	org.IsDeprecated = conceptsApiResponse.IsDeprecated
+	if unknown := unknownCountryCodes(org); len(unknown) > 0 {
+		logger.WithTransactionID(transID).WithUUID(uuid).Warnf("organisation has unknown ISO 3166-1 country codes: %s", strings.Join(unknown, ", "))
+	}
+	org.Aliases = aliasUUIDs(org.ID, redirectedFrom, conceptsApiResponse.SourceRepresentations)
+	org.Identifiers = identifiersByAuthority(conceptsApiResponse.SourceRepresentations)
 
 	formerNames := []string{}
+	formerNameHistory := []FormerName{}
 	m := make(map[string]bool)
 	uniqLabel := []string{}
 	for _, label := range conceptsApiResponse.AlternativeLabels {
 		compare := func(expected string) bool {
 			return strings.TrimPrefix(label.Type, ontologyPrefix) == expected
 		}
+		// the singular label fields keep the last label of their type in the default language
+		localised := label.Language != "" && !matchesLanguage(label.Language, defaultLanguage)
 		switch {
-		case compare("/properName"):
+		case compare("/properName") && !localised:
 			org.ProperName = label.Value
-		case compare("/shortName"):
+		case compare("/shortName") && !localised:
 			org.ShortName = label.Value
-		case compare("/hiddenLabel"):
+		case compare("/hiddenLabel") && !localised:
 			org.HiddenLabel = label.Value
 		case compare("/formerName"):
 			formerNames = append(formerNames, label.Value)
+			formerNameHistory = append(formerNameHistory, FormerName{Name: label.Value, ValidFrom: label.ValidFrom, ValidTo: label.ValidTo})
 		}
 
 		if !m[label.Value] {
@@ -256,24 +379,33 @@ func (h *OrganisationsHandler) getOrganisationViaConceptsAPI(uuid string, transI
 	}
 	if len(formerNames) > 0 {
 		org.FormerNames = formerNames
+		sortFormerNames(formerNameHistory)
+		org.FormerNameHistory = formerNameHistory
 	}
 	if len(uniqLabel) > 0 {
 		org.Labels = uniqLabel
 	}
+	org.LocalisedLabels, org.localisedPrefLabels = localisedLabels(conceptsApiResponse.AlternativeLabels)
+	if len(conceptsApiResponse.AlternativeLabels) > 0 {
+		org.TypedLabels = append([]TypedValue{}, conceptsApiResponse.AlternativeLabels...)
+	}
 
+	var parents = []Parent{}
 	var subsidiaries = []Subsidiary{}
+	var classifications = []IndustryClassification{}
+	related := map[string][]ConceptSummary{}
 	for _, item := range conceptsApiResponse.Related {
 		c := item.Concept
-		if strings.TrimPrefix(item.Predicate, ontologyPrefix) == hasParentPredicate {
-			parent := &Parent{}
+		switch strings.TrimPrefix(item.Predicate, ontologyPrefix) {
+		case hasParentPredicate:
+			parent := Parent{}
 			parent.ID = convertID(c.ID)
 			parent.APIURL = convertApiUrl(c.ApiURL, "organisations")
 			parent.PrefLabel = c.PrefLabel
 			parent.DirectType = c.Type
 			parent.Types = mapper.FullTypeHierarchy(c.Type)
-			org.Parent = parent
-		}
-		if strings.TrimPrefix(item.Predicate, ontologyPrefix) == isParentPredicate {
+			parents = append(parents, parent)
+		case isParentPredicate:
 			subsidiary := Subsidiary{}
 			subsidiary.ID = convertID(c.ID)
 			subsidiary.APIURL = convertApiUrl(c.ApiURL, "organisations")
@@ -281,8 +413,7 @@ func (h *OrganisationsHandler) getOrganisationViaConceptsAPI(uuid string, transI
 			subsidiary.DirectType = c.Type
 			subsidiary.Types = mapper.FullTypeHierarchy(c.Type)
 			subsidiaries = append(subsidiaries, subsidiary)
-		}
-		if strings.TrimPrefix(item.Predicate, ontologyPrefix) == issuedPredicate {
+		case issuedPredicate:
 			f := &FinancialInstrument{}
 			f.ID = convertID(c.ID)
 			f.APIURL = convertApiUrl(c.ApiURL, "things")
@@ -291,15 +422,218 @@ func (h *OrganisationsHandler) getOrganisationViaConceptsAPI(uuid string, transI
 			f.Types = mapper.FullTypeHierarchy(c.Type)
 			f.Figi = c.Figi
 			org.FinancialInstrument = f
+			org.FinancialInstruments = append(org.FinancialInstruments, *f)
+		case isReplacedByPredicate, mergedIntoPredicate:
+			if org.ReplacedBy == nil {
+				successor := newConceptSummary(c)
+				org.ReplacedBy = &successor
+			}
+		case industryClassificationPredicate:
+			classifications = append(classifications, newIndustryClassification(c))
+		default:
+			predicate := predicateName(item.Predicate)
+			if predicate != "" && opts.includesRelationship(predicate) {
+				related[predicate] = append(related[predicate], newConceptSummary(c))
+			}
 		}
 	}
+	if len(parents) > 0 {
+		org.ParentOrganisations = parents
+		org.Parent = h.primaryParent(parents)
+	}
+	if len(parents) > 1 {
+		logger.WithTransactionID(transID).WithUUID(uuid).Warnf("organisation has %d parent organisations, using %s as primary parent", len(parents), org.Parent.ID)
+	}
+	if len(classifications) > 0 {
+		org.IndustryClassifications = classifications
+	}
+	if len(related) > 0 {
+		org.Related = related
+	}
 	if len(subsidiaries) > 0 {
 		org.Subsidiaries = subsidiaries
 	}
+	if opts.broader {
+		org.BroaderConcepts = conceptSummaries(conceptsApiResponse.Broader)
+	}
+	if opts.narrower {
+		org.NarrowerConcepts = conceptSummaries(conceptsApiResponse.Narrower)
+	}
 
 	return org, true, nil
 }
 
+// getConcept retrieves the concept from public-concepts-api, following up to maxRedirectHops redirects
+// to other concepts. The UUIDs that were redirected from are returned alongside the concept.
+func (h *OrganisationsHandler) getConcept(ctx context.Context, uuid string, transID string, opts requestOptions) (ConceptApiResponse, []string, bool, error) {
+	conceptsApiResponse := ConceptApiResponse{}
+	redirectedFrom := []string{}
+
+	for hops := 0; ; hops++ {
+		reqURL := h.conceptsURL + "/concepts/" + uuid + opts.conceptsQuery()
+		request, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+		if err != nil {
+			msg := fmt.Sprintf("failed to create request to %s", reqURL)
+			logger.WithError(err).WithUUID(uuid).WithTransactionID(transID).Error(msg)
+			return conceptsApiResponse, redirectedFrom, false, err
+		}
+
+		request.Header.Set("X-Request-Id", transID)
+		resp, err := h.client.Do(request)
+		if err != nil {
+			msg := fmt.Sprintf("request to %s was unsuccessful", reqURL)
+			logger.WithError(err).WithUUID(uuid).WithTransactionID(transID).Error(msg)
+			return conceptsApiResponse, redirectedFrom, false, err
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			resp.Body.Close()
+			return conceptsApiResponse, redirectedFrom, false, nil
+		}
+
+		if isRedirect(resp.StatusCode) {
+			resp.Body.Close()
+			next := uuidMatcher.FindString(resp.Header.Get("Location"))
+			if next == "" {
+				err = newContractViolation(reqURL, fmt.Sprintf("redirect to '%s' has no concept uuid", resp.Header.Get("Location")))
+				logger.WithError(err).WithUUID(uuid).WithTransactionID(transID).Error("failed to resolve concept")
+				return conceptsApiResponse, redirectedFrom, false, err
+			}
+			if hops >= maxRedirectHops {
+				err = fmt.Errorf("unable to follow redirect from %s to '%s' after %d hops", reqURL, resp.Header.Get("Location"), hops)
+				logger.WithError(err).WithUUID(uuid).WithTransactionID(transID).Error("failed to resolve concept")
+				return conceptsApiResponse, redirectedFrom, false, err
+			}
+			redirectedFrom = append(redirectedFrom, uuid)
+			uuid = next
+			continue
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			err = fmt.Errorf("request to %s returned status %d", reqURL, resp.StatusCode)
+			logger.WithError(err).WithUUID(uuid).WithTransactionID(transID).Error("failed to get concept")
+			return conceptsApiResponse, redirectedFrom, false, err
+		}
+
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			msg := fmt.Sprintf("failed to read response body: %v", resp.Body)
+			logger.WithError(err).WithUUID(uuid).WithTransactionID(transID).Error(msg)
+			return conceptsApiResponse, redirectedFrom, false, err
+		}
+
+		if err = json.Unmarshal(body, &conceptsApiResponse); err != nil {
+			msg := fmt.Sprintf("failed to unmarshal response body: %v", body)
+			logger.WithError(err).WithUUID(uuid).WithTransactionID(transID).Error(msg)
+			return conceptsApiResponse, redirectedFrom, false, newContractViolation(reqURL, err.Error())
+		}
+		if problems := validateConcept(conceptsApiResponse); len(problems) > 0 {
+			err = newContractViolation(reqURL, problems...)
+			logger.WithError(err).WithUUID(uuid).WithTransactionID(transID).Error("invalid concept")
+			return conceptsApiResponse, redirectedFrom, false, err
+		}
+		return conceptsApiResponse, redirectedFrom, true, nil
+	}
+}
+
+// messageJSON is the body of an error response, escaping the message as it may repeat the request
+func messageJSON(msg string) []byte {
+	quoted, _ := json.Marshal(msg)
+	return []byte(`{"message": ` + string(quoted) + `}`)
+}
+
+func isRedirect(statusCode int) bool {
+	switch statusCode {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		return true
+	}
+	return false
+}
+
+// aliasUUIDs lists, without duplicates, every UUID other than the canonical one that identifies the organisation
+func aliasUUIDs(canonicalID string, redirectedFrom []string, sources []SourceRepresentation) []string {
+	canonicalUUID := uuidMatcher.FindString(canonicalID)
+	seen := map[string]bool{canonicalUUID: true}
+	aliases := []string{}
+	add := func(uuid string) {
+		if isUUID(uuid) && !seen[uuid] {
+			seen[uuid] = true
+			aliases = append(aliases, uuid)
+		}
+	}
+
+	for _, uuid := range redirectedFrom {
+		add(uuid)
+	}
+	for _, source := range sources {
+		add(source.UUID)
+	}
+	if len(aliases) == 0 {
+		return nil
+	}
+	return aliases
+}
+
+// primaryParent chooses the parentOrganisation among the parents, in upstream order
+func (h *OrganisationsHandler) primaryParent(parents []Parent) *Parent {
+	if h.preferredParentType != "" {
+		for i := range parents {
+			for _, t := range parents[i].Types {
+				if t == h.preferredParentType {
+					return &parents[i]
+				}
+			}
+		}
+	}
+	return &parents[0]
+}
+
+// conceptSummaries maps broader or narrower concepts, such as brands and industries, to typed summaries
+func conceptSummaries(related []RelatedConcept) []ConceptSummary {
+	summaries := []ConceptSummary{}
+	for _, item := range related {
+		summaries = append(summaries, newConceptSummary(item.Concept))
+	}
+	if len(summaries) == 0 {
+		return nil
+	}
+	return summaries
+}
+
+func newConceptSummary(c Concept) ConceptSummary {
+	summary := ConceptSummary{}
+	summary.ID = convertID(c.ID)
+	if isOrganisationType(c.Type) {
+		summary.APIURL = convertApiUrl(c.ApiURL, "organisations")
+	} else {
+		summary.APIURL = convertApiUrl(c.ApiURL, "things")
+	}
+	summary.PrefLabel = c.PrefLabel
+	summary.DirectType = c.Type
+	summary.Types = mapper.FullTypeHierarchy(c.Type)
+	return summary
+}
+
+// predicateName shortens FT ontology predicates, e.g. http://www.ft.com/ontology/hasIndustryClassification
+// becomes hasIndustryClassification. Predicates from other vocabularies keep their full URI.
+func predicateName(predicate string) string {
+	if strings.HasPrefix(predicate, ontologyPrefix+"/") {
+		return strings.TrimPrefix(predicate, ontologyPrefix+"/")
+	}
+	return predicate
+}
+
+func isOrganisationType(conceptType string) bool {
+	for _, t := range mapper.FullTypeHierarchy(conceptType) {
+		if t == ontologyPrefix+organisationSuffix {
+			return true
+		}
+	}
+	return false
+}
+
 func convertApiUrl(conceptsApiUrl string, desired string) string {
 	return strings.Replace(conceptsApiUrl, "concepts", desired, 1)
 }
@@ -2,13 +2,16 @@ package organisations
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/Financial-Times/go-logger"
 	"github.com/gorilla/mux"
@@ -16,7 +19,7 @@ import (
 )
 
 const (
-	expectedCacheControlHeader string = "special header"
+	expectedCacheControlHeader string = "max-age=30, public, stale-if-error=3600"
 )
 
 type mockHTTPClient struct {
@@ -44,6 +47,34 @@ func (mhc *mockHTTPClient) Do(req *http.Request) (resp *http.Response, err error
 	return &http.Response{Body: cb, StatusCode: mhc.statusCode}, mhc.err
 }
 
+type mockResponse struct {
+	statusCode int
+	body       string
+	location   string
+}
+
+// mockRoutingHTTPClient responds according to the path and query of the request
+type mockRoutingHTTPClient struct {
+	responses map[string]mockResponse
+	requests  []string
+	mu        sync.Mutex
+}
+
+func (m *mockRoutingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = append(m.requests, req.URL.RequestURI())
+	r, found := m.responses[req.URL.RequestURI()]
+	if !found {
+		r = mockResponse{statusCode: 404}
+	}
+	header := http.Header{}
+	if r.location != "" {
+		header.Set("Location", r.location)
+	}
+	return &http.Response{Body: ioutil.NopCloser(strings.NewReader(r.body)), StatusCode: r.statusCode, Header: header}, nil
+}
+
 func TestHandlers(t *testing.T) {
 	logger.InitLogger("test-service", "debug")
 	var mockClient mockHTTPClient
@@ -82,8 +113,8 @@ func TestHandlers(t *testing.T) {
 		200,
 		`{`,
 		nil,
-		500,
-		`{"message": "failed to return organisation"}`,
+		502,
+		`{"message": "public-concepts-api returned an invalid organisation"}`,
 	}
 	notFound := testCase{
 		"Get organisation - not found",
@@ -162,10 +193,9 @@ func TestHeadersOKOnFoundForCanonicalNode(t *testing.T) {
 	mockClient.statusCode = 200
 	mockClient.err = nil
 
-	CacheControlHeader = expectedCacheControlHeader
-
 	router := mux.NewRouter()
 	bh := NewHandler(&mockClient, "localhost:8080/concepts")
+	bh.UseCachePolicy(CachePolicy{OK: CacheDirectives{MaxAge: 30 * time.Second, StaleIfError: time.Hour}})
 	bh.RegisterHandlers(router)
 
 	rec := httptest.NewRecorder()
@@ -178,6 +208,538 @@ func TestHeadersOKOnFoundForCanonicalNode(t *testing.T) {
 	assert.Equal(t, "application/json; charset=UTF-8", rec.Header().Get("Content-Type"))
 }
 
+func TestCacheControlHeaderPerResponseClass(t *testing.T) {
+	var mockClient mockHTTPClient
+	router := mux.NewRouter()
+	bh := NewHandler(&mockClient, "localhost:8080/concepts")
+	bh.UseCachePolicy(CachePolicy{
+		OK:         CacheDirectives{MaxAge: time.Minute},
+		Redirect:   CacheDirectives{MaxAge: time.Hour, SMaxAge: 24 * time.Hour},
+		NotFound:   CacheDirectives{MaxAge: 10 * time.Second, StaleWhileRevalidate: 5 * time.Second},
+		Deprecated: CacheDirectives{MaxAge: 2 * time.Minute, StaleIfError: time.Minute},
+	})
+	bh.RegisterHandlers(router)
+
+	testCases := []struct {
+		name       string
+		url        string
+		clientCode int
+		clientBody string
+		expected   string
+	}{
+		{"Found", "/organisations/7c5218a0-3755-463e-abbc-1a1632cfd1da", 200, getCompleteOrganisationAsConcept, "max-age=60, public"},
+		{"Redirect", "/organisations/2d3e16e0-61cb-4322-8aff-3b01c59f4daa", 200, getRedirectedOrganisation, "max-age=3600, public, s-maxage=86400"},
+		{"Not found", "/organisations/2d3e16e0-61cb-4322-8aff-3b01c59f4daa", 404, "", "max-age=10, public, stale-while-revalidate=5"},
+		{"Deprecated", "/organisations/6fc8fbac-b4ee-11e8-a790-6c96cfdf3997", 200, getCompleteDeprecatedOrganisationAsConcept, "max-age=120, public, stale-if-error=60"},
+		{"Bad request", "/organisations/1234", 200, "", ""},
+	}
+
+	for _, test := range testCases {
+		mockClient.resp = test.clientBody
+		mockClient.statusCode = test.clientCode
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", test.url, nil)
+		router.ServeHTTP(rec, req)
+		assert.Equal(t, test.expected, rec.Header().Get("Cache-Control"), test.name+" failed: Cache-Control does not match!")
+	}
+}
+
+func TestGetOrganisationUsesCache(t *testing.T) {
+	var mockClient mockHTTPClient
+	mockClient.resp = getBasicOrganisationAsConcept
+	mockClient.statusCode = 200
+
+	cache := NewCache(time.Minute, 100)
+	router := mux.NewRouter()
+	bh := NewHandler(&mockClient, "localhost:8080/concepts")
+	bh.UseCache(cache)
+	bh.RegisterHandlers(router)
+
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6", nil)
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, 1, cache.Len())
+
+	mockClient.err = errors.New("Downstream error")
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, 200, rec.Code, "cached organisation should be served without calling concepts API")
+
+	cache.Evict("d6b12f0c-bf3f-4045-a07b-1e4e49103fd6")
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, 500, rec.Code)
+}
+
+func TestSurrogateKeyHeader(t *testing.T) {
+	var mockClient mockHTTPClient
+	mockClient.statusCode = 200
+	router := mux.NewRouter()
+	bh := NewHandler(&mockClient, "localhost:8080/concepts")
+	bh.RegisterHandlers(router)
+
+	testCases := []struct {
+		name     string
+		url      string
+		concept  string
+		expected string
+	}{
+		{
+			"Embedded concepts are listed",
+			"/organisations/7c5218a0-3755-463e-abbc-1a1632cfd1da",
+			getCompleteOrganisationAsConcept,
+			"7c5218a0-3755-463e-abbc-1a1632cfd1da 335e9e5a-8f2e-11e8-8f42-da24cd01f044 1b070fbb-6331-3225-bb57-9108deb67df4 dfee4b8f-ceee-37ba-ab24-752cf7a9281c",
+		},
+		{
+			"Redirect lists canonical and requested uuid",
+			"/organisations/2d3e16e0-61cb-4322-8aff-3b01c59f4daa",
+			getRedirectedOrganisation,
+			"d6b12f0c-bf3f-4045-a07b-1e4e49103fd6 2d3e16e0-61cb-4322-8aff-3b01c59f4daa",
+		},
+		{
+			"Canonical lists its aliases",
+			"/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+			getOrganisationWithSources,
+			"d6b12f0c-bf3f-4045-a07b-1e4e49103fd6 5c8a1a5d-ad8f-3ac5-8c84-a7e8a3e0e31a",
+		},
+	}
+
+	for _, test := range testCases {
+		mockClient.resp = test.concept
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", test.url, nil)
+		router.ServeHTTP(rec, req)
+		assert.Equal(t, test.expected, rec.Header().Get("Surrogate-Key"), test.name+" failed: surrogate keys do not match!")
+	}
+}
+
+func TestSurrogateKeyListsEveryAliasOnFirstRequest(t *testing.T) {
+	mockClient := &mockRoutingHTTPClient{responses: map[string]mockResponse{
+		"/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6?showRelationship=related": {statusCode: 200, body: getOrganisationWithSources},
+	}}
+	router := mux.NewRouter()
+	bh := NewHandler(mockClient, "")
+	bh.RegisterHandlers(router)
+
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6", nil)
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, "d6b12f0c-bf3f-4045-a07b-1e4e49103fd6 5c8a1a5d-ad8f-3ac5-8c84-a7e8a3e0e31a", rec.Header().Get("Surrogate-Key"),
+		"a handler that has seen no alias requests should list the aliases of the organisation")
+}
+
+func TestAliasesAndRedirectChains(t *testing.T) {
+	mockClient := &mockRoutingHTTPClient{responses: map[string]mockResponse{
+		"/concepts/2d3e16e0-61cb-4322-8aff-3b01c59f4daa?showRelationship=related": {statusCode: 301, location: "/concepts/f92a4ca4-84f9-11e8-8f42-da24cd01f044"},
+		"/concepts/f92a4ca4-84f9-11e8-8f42-da24cd01f044?showRelationship=related": {statusCode: 308, location: "http://localhost:8080/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6"},
+		"/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6?showRelationship=related": {statusCode: 200, body: getOrganisationWithSources},
+		"/concepts/6fc8fbac-b4ee-11e8-a790-6c96cfdf3997?showRelationship=related": {statusCode: 301, location: "/concepts/6fc8fbac-b4ee-11e8-a790-6c96cfdf3997"},
+		"/concepts/335e9e5a-8f2e-11e8-8f42-da24cd01f044?showRelationship=related": {statusCode: 301, location: "/concepts/not-a-uuid"},
+	}}
+	router := mux.NewRouter()
+	bh := NewHandler(mockClient, "")
+	bh.RegisterHandlers(router)
+
+	testCases := []struct {
+		name                    string
+		url                     string
+		expectedCode            int
+		expectedLocation        string
+		expectedContentLocation string
+		expectedBody            string
+	}{
+		{
+			"Canonical organisation lists aliases",
+			"/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+			200,
+			"",
+			"",
+			getTransformedOrganisationWithSources,
+		},
+		{
+			"Redirect chain is followed",
+			"/organisations/2d3e16e0-61cb-4322-8aff-3b01c59f4daa",
+			301,
+			"/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+			"",
+			"",
+		},
+		{
+			"Aliases resolved server side",
+			"/organisations/2d3e16e0-61cb-4322-8aff-3b01c59f4daa?resolveAliases=true",
+			200,
+			"",
+			"/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+			getTransformedOrganisationWithSourcesAndRedirects,
+		},
+		{
+			"Redirect loop fails",
+			"/organisations/6fc8fbac-b4ee-11e8-a790-6c96cfdf3997",
+			500,
+			"",
+			"",
+			`{"message": "failed to return organisation"}`,
+		},
+		{
+			"Redirect without uuid fails",
+			"/organisations/335e9e5a-8f2e-11e8-8f42-da24cd01f044",
+			502,
+			"",
+			"",
+			`{"message": "public-concepts-api returned an invalid organisation"}`,
+		},
+	}
+
+	for _, test := range testCases {
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", test.url, nil)
+		req.Header.Set("Accept", v2MediaType)
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, test.expectedCode, rec.Code, test.name+" failed: status codes do not match!")
+		assert.Equal(t, test.expectedLocation, rec.Header().Get("Location"), test.name+" failed: Location does not match!")
+		assert.Equal(t, test.expectedContentLocation, rec.Header().Get("Content-Location"), test.name+" failed: Content-Location does not match!")
+		if rec.Code == 200 {
+			assert.Equal(t, transformBody(test.expectedBody), rec.Body.String(), test.name+" failed: status body does not match!")
+			continue
+		}
+		assert.Equal(t, test.expectedBody, rec.Body.String(), test.name+" failed: status body does not match!")
+	}
+}
+
+func TestGetOrganisationByIdentifier(t *testing.T) {
+	mockClient := &mockRoutingHTTPClient{responses: map[string]mockResponse{
+		"/concepts?authority=FACTSET&identifierValue=000C7F-E":                     {statusCode: 200, body: `{"id": "http://www.ft.com/thing/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6"}`},
+		"/concepts?authority=TME&identifierValue=TnN0ZWluX09OX0ZvcnR1bmVDb21wYW55": {statusCode: 301, location: "/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6"},
+		"/concepts?authority=TME&identifierValue=UGVyc29u":                         {statusCode: 200, body: `{"id": "http://www.ft.com/thing/f92a4ca4-84f9-11e8-8f42-da24cd01f044"}`},
+		"/concepts?authority=Wikidata&identifierValue=Q95":                         {statusCode: 503},
+		"/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6?showRelationship=related":  {statusCode: 200, body: getOrganisationWithSources},
+		"/concepts/f92a4ca4-84f9-11e8-8f42-da24cd01f044?showRelationship=related":  {statusCode: 200, body: getPersonAsConcept},
+	}}
+	router := mux.NewRouter()
+	bh := NewHandler(mockClient, "")
+	bh.RegisterHandlers(router)
+
+	testCases := []struct {
+		name                    string
+		url                     string
+		expectedCode            int
+		expectedLocation        string
+		expectedContentLocation string
+		expectedBody            string
+	}{
+		{"Missing identifier value", "/organisations?authority=FACTSET", 400, "", "", `{"message": "both authority and identifierValue query parameters are required"}`},
+		{"Concept returned", "/organisations?authority=FACTSET&identifierValue=000C7F-E", 302, "/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6", "", ""},
+		{"Concept redirected to", "/organisations?authority=TME&identifierValue=TnN0ZWluX09OX0ZvcnR1bmVDb21wYW55", 302, "/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6", "", ""},
+		{"Resolved server side", "/organisations?authority=FACTSET&identifierValue=000C7F-E&resolveAliases=true", 200, "", "/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6", getTransformedOrganisationWithSources},
+		{"Unknown identifier", "/organisations?authority=FACTSET&identifierValue=unknown", 404, "", "", `{"message": "organisation not found"}`},
+		{"Concept is not an organisation", "/organisations?authority=TME&identifierValue=UGVyc29u", 404, "", "", `{"message": "organisation not found"}`},
+		{"Concepts API error", "/organisations?authority=Wikidata&identifierValue=Q95", 500, "", "", `{"message": "failed to return organisation"}`},
+	}
+
+	for _, test := range testCases {
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", test.url, nil)
+		req.Header.Set("Accept", v2MediaType)
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, test.expectedCode, rec.Code, test.name+" failed: status codes do not match!")
+		assert.Equal(t, test.expectedLocation, rec.Header().Get("Location"), test.name+" failed: Location does not match!")
+		assert.Equal(t, test.expectedContentLocation, rec.Header().Get("Content-Location"), test.name+" failed: Content-Location does not match!")
+		if rec.Code == 200 {
+			assert.Equal(t, transformBody(test.expectedBody), rec.Body.String(), test.name+" failed: status body does not match!")
+			continue
+		}
+		assert.Equal(t, test.expectedBody, rec.Body.String(), test.name+" failed: status body does not match!")
+	}
+}
+
+func TestIdentifiersByAuthorityIgnoresAuthorityCase(t *testing.T) {
+	testCases := []struct {
+		name    string
+		sources []SourceRepresentation
+	}{
+		{"Upper case first", []SourceRepresentation{
+			{Authority: "FACTSET", AuthorityValue: "000C7F-E"},
+			{Authority: "FactSet", AuthorityValue: "000C7F-E"},
+			{Authority: "FactSet", AuthorityValue: "05HVRR-E"},
+			{Authority: "Wikidata", AuthorityValue: "Q95"},
+		}},
+		{"Mixed case first", []SourceRepresentation{
+			{Authority: "FactSet", AuthorityValue: "000C7F-E"},
+			{Authority: "FACTSET", AuthorityValue: "000C7F-E"},
+			{Authority: "FACTSET", AuthorityValue: "05HVRR-E"},
+			{Authority: "wikidata", AuthorityValue: "Q95"},
+		}},
+	}
+
+	for _, test := range testCases {
+		assert.Equal(t, map[string][]string{
+			"FACTSET":  {"000C7F-E", "05HVRR-E"},
+			"WIKIDATA": {"Q95"},
+		}, identifiersByAuthority(test.sources), test.name+" failed: identifiers do not match!")
+	}
+}
+
+func TestBroaderAndNarrowerConcepts(t *testing.T) {
+	mockClient := &mockRoutingHTTPClient{responses: map[string]mockResponse{
+		"/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6?showRelationship=related":                                                    {statusCode: 200, body: getOrganisationWithBroaderAndNarrower},
+		"/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6?showRelationship=related&showRelationship=broader":                           {statusCode: 200, body: getOrganisationWithBroaderAndNarrower},
+		"/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6?showRelationship=related&showRelationship=broader&showRelationship=narrower": {statusCode: 200, body: getOrganisationWithBroaderAndNarrower},
+	}}
+	router := mux.NewRouter()
+	bh := NewHandler(mockClient, "")
+	bh.RegisterHandlers(router)
+
+	testCases := []struct {
+		name         string
+		url          string
+		expectedBody string
+	}{
+		{"Excluded by default", "/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6", getTransformedOrganisationWithoutBroaderAndNarrower},
+		{"Broader included", "/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6?include=broader", getTransformedOrganisationWithBroader},
+		{"Broader and narrower included", "/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6?include=narrower&include=broader", getTransformedOrganisationWithBroaderAndNarrower},
+	}
+
+	for _, test := range testCases {
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", test.url, nil)
+		req.Header.Set("Accept", v2MediaType)
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, 200, rec.Code, test.name+" failed: status codes do not match!")
+		assert.Equal(t, transformBody(test.expectedBody), rec.Body.String(), test.name+" failed: status body does not match!")
+	}
+}
+
+func TestRelatedConceptsByPredicate(t *testing.T) {
+	mockClient := &mockRoutingHTTPClient{responses: map[string]mockResponse{
+		"/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6?showRelationship=related":                           {statusCode: 200, body: getOrganisationWithOtherPredicates},
+		"/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6?showRelationship=related&showRelationship=hasBrand": {statusCode: 200, body: getOrganisationWithOtherPredicates},
+	}}
+	router := mux.NewRouter()
+	bh := NewHandler(mockClient, "")
+	bh.RegisterHandlers(router)
+
+	testCases := []struct {
+		name         string
+		url          string
+		expectedBody string
+	}{
+		{"Every predicate by default", "/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6", getTransformedOrganisationWithOtherPredicates},
+		{"Selected predicates", "/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6?relationships=http://www.ft.com/ontology/hasBrand", getTransformedOrganisationWithBrands},
+	}
+
+	for _, test := range testCases {
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", test.url, nil)
+		req.Header.Set("Accept", v2MediaType)
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, 200, rec.Code, test.name+" failed: status codes do not match!")
+		assert.Equal(t, transformBody(test.expectedBody), rec.Body.String(), test.name+" failed: status body does not match!")
+	}
+}
+
+func TestRelatedConceptsAreCachedByPredicates(t *testing.T) {
+	mockClient := &mockRoutingHTTPClient{responses: map[string]mockResponse{
+		"/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6?showRelationship=related":                           {statusCode: 200, body: getOrganisationWithOtherPredicates},
+		"/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6?showRelationship=related&showRelationship=hasBrand": {statusCode: 200, body: getOrganisationWithOtherPredicates},
+	}}
+	cache := NewCache(time.Minute, 100)
+	router := mux.NewRouter()
+	bh := NewHandler(mockClient, "")
+	bh.UseCache(cache)
+	bh.RegisterHandlers(router)
+
+	testCases := []struct {
+		name         string
+		url          string
+		expectedBody string
+	}{
+		{"Every predicate", "/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6", getTransformedOrganisationWithOtherPredicates},
+		{"Selected predicates", "/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6?relationships=hasBrand", getTransformedOrganisationWithBrands},
+		{"Cached selected predicates", "/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6?relationships=http://www.ft.com/ontology/hasBrand", getTransformedOrganisationWithBrands},
+		{"Cached every predicate", "/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6", getTransformedOrganisationWithOtherPredicates},
+	}
+
+	for _, test := range testCases {
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", test.url, nil)
+		req.Header.Set("Accept", v2MediaType)
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, 200, rec.Code, test.name+" failed: status codes do not match!")
+		assert.Equal(t, transformBody(test.expectedBody), rec.Body.String(), test.name+" failed: status body does not match!")
+	}
+	assert.Equal(t, []string{
+		"/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6?showRelationship=related",
+		"/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6?showRelationship=related&showRelationship=hasBrand",
+	}, mockClient.requests, "each set of predicates should be fetched once")
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestPrimaryParentOfOrganisationWithSeveralParents(t *testing.T) {
+	testCases := []struct {
+		name          string
+		preferredType string
+		expectedID    string
+	}{
+		{"First in upstream order", "", "http://api.ft.com/things/335e9e5a-8f2e-11e8-8f42-da24cd01f044"},
+		{"Preferred type", "http://www.ft.com/ontology/company/PublicCompany", "http://api.ft.com/things/1b070fbb-6331-3225-bb57-9108deb67df4"},
+		{"Preferred type missing", "http://www.ft.com/ontology/company/PrivateCompany", "http://api.ft.com/things/335e9e5a-8f2e-11e8-8f42-da24cd01f044"},
+	}
+
+	for _, test := range testCases {
+		mockClient := &mockHTTPClient{resp: getOrganisationWithSeveralParents, statusCode: 200}
+		bh := NewHandler(mockClient, "")
+		bh.UsePreferredParentType(test.preferredType)
+
+		org, found, err := bh.getOrganisationViaConceptsAPI(context.Background(), "d6b12f0c-bf3f-4045-a07b-1e4e49103fd6", "tid_test", requestOptions{})
+		assert.NoError(t, err, test.name+" failed: unexpected error")
+		assert.True(t, found, test.name+" failed: organisation not found")
+		assert.Len(t, org.ParentOrganisations, 2, test.name+" failed: parents do not match!")
+		assert.Equal(t, "http://api.ft.com/things/335e9e5a-8f2e-11e8-8f42-da24cd01f044", org.ParentOrganisations[0].ID, test.name+" failed: parents are not in upstream order!")
+		assert.Equal(t, test.expectedID, org.Parent.ID, test.name+" failed: primary parent does not match!")
+	}
+}
+
+func TestExpandCountry(t *testing.T) {
+	mockClient := &mockHTTPClient{resp: getCompleteOrganisationAsConcept, statusCode: 200}
+	router := mux.NewRouter()
+	bh := NewHandler(mockClient, "")
+	bh.RegisterHandlers(router)
+
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/organisations/7c5218a0-3755-463e-abbc-1a1632cfd1da", nil)
+	req.Header.Set("Accept", v2MediaType)
+	router.ServeHTTP(rec, req)
+	assert.NotContains(t, rec.Body.String(), "countryCodeDetails")
+
+	rec = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/organisations/7c5218a0-3755-463e-abbc-1a1632cfd1da?expand=country", nil)
+	req.Header.Set("Accept", v2MediaType)
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"countryCode":"JP","countryOfIncorporation":"JP","countryCodeDetails":{"code":"JP","alpha3":"JPN","name":"Japan","region":"Asia"},"countryOfIncorporationDetails":{"code":"JP","alpha3":"JPN","name":"Japan","region":"Asia"}`)
+}
+
+func TestDeprecatedOrganisationWithSuccessor(t *testing.T) {
+	testCases := []struct {
+		name               string
+		redirect           bool
+		expectedCode       int
+		expectedLocation   string
+		expectedLink       string
+		expectedReplacedBy string
+	}{
+		{"Successor linked", false, 200, "", `</organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6>; rel="successor-version"`, `"replacedBy":{"id":"http://api.ft.com/things/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6"`},
+		{"Redirected to successor", true, 301, "/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6", `</organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6>; rel="successor-version"`, ""},
+	}
+
+	for _, test := range testCases {
+		mockClient := &mockHTTPClient{resp: getDeprecatedOrganisationWithSuccessor, statusCode: 200}
+		router := mux.NewRouter()
+		bh := NewHandler(mockClient, "")
+		bh.RedirectDeprecated(test.redirect)
+		bh.RegisterHandlers(router)
+
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/organisations/6fc8fbac-b4ee-11e8-a790-6c96cfdf3997", nil)
+		req.Header.Set("Accept", v2MediaType)
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, test.expectedCode, rec.Code, test.name+" failed: status codes do not match!")
+		assert.Equal(t, "true", rec.Header().Get("Deprecation"), test.name+" failed: Deprecation does not match!")
+		assert.Equal(t, test.expectedLink, rec.Header().Get("Link"), test.name+" failed: Link does not match!")
+		assert.Equal(t, test.expectedLocation, rec.Header().Get("Location"), test.name+" failed: Location does not match!")
+		assert.Contains(t, rec.Body.String(), test.expectedReplacedBy, test.name+" failed: replacedBy does not match!")
+	}
+}
+
+func TestDeprecatedOrganisationWithoutSuccessor(t *testing.T) {
+	mockClient := &mockHTTPClient{resp: getCompleteDeprecatedOrganisationAsConcept, statusCode: 200}
+	router := mux.NewRouter()
+	bh := NewHandler(mockClient, "")
+	bh.RedirectDeprecated(true)
+	bh.RegisterHandlers(router)
+
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/organisations/6fc8fbac-b4ee-11e8-a790-6c96cfdf3997", nil)
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, "true", rec.Header().Get("Deprecation"))
+	assert.Equal(t, "", rec.Header().Get("Link"))
+}
+
+func TestNameAt(t *testing.T) {
+	testCases := []struct {
+		name          string
+		url           string
+		expectedCode  int
+		expectedLabel string
+	}{
+		{"Former name", "/organisations/7c5218a0-3755-463e-abbc-1a1632cfd1da?nameAt=1920-06-01", 200, `"prefLabelAtDate":"Nintendo Playing Card Co., Ltd."`},
+		{"Current name", "/organisations/7c5218a0-3755-463e-abbc-1a1632cfd1da?nameAt=1990-06-01", 200, `"prefLabelAtDate":"Nintendo Co Ltd"`},
+		{"Invalid date", "/organisations/7c5218a0-3755-463e-abbc-1a1632cfd1da?nameAt=June", 400, `"message": "nameAt 'June' is not a date in the YYYY-MM-DD format"`},
+	}
+
+	for _, test := range testCases {
+		mockClient := &mockHTTPClient{resp: getDatedFormerNameOrganisationAsConcept, statusCode: 200}
+		router := mux.NewRouter()
+		bh := NewHandler(mockClient, "")
+		bh.RegisterHandlers(router)
+
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", test.url, nil)
+		req.Header.Set("Accept", v2MediaType)
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, test.expectedCode, rec.Code, test.name+" failed: status codes do not match!")
+		assert.Contains(t, rec.Body.String(), test.expectedLabel, test.name+" failed: labels do not match!")
+	}
+}
+
+func TestAcceptLanguage(t *testing.T) {
+	testCases := []struct {
+		name                    string
+		acceptLanguage          string
+		expectedContentLanguage string
+		expectedPrefLabel       string
+	}{
+		{"No Accept-Language", "", "", `"prefLabel":"Nintendo Co Ltd"`},
+		{"Localised", "ja-JP, en;q=0.5", "ja", `"prefLabel":"任天堂株式会社"`},
+		{"Default language", "de", "en", `"prefLabel":"Nintendo Co Ltd"`},
+	}
+
+	for _, test := range testCases {
+		mockClient := &mockHTTPClient{resp: getLocalisedOrganisationAsConcept, statusCode: 200}
+		router := mux.NewRouter()
+		bh := NewHandler(mockClient, "")
+		bh.RegisterHandlers(router)
+
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/organisations/7c5218a0-3755-463e-abbc-1a1632cfd1da", nil)
+		req.Header.Set("Accept", v2MediaType)
+		if test.acceptLanguage != "" {
+			req.Header.Set("Accept-Language", test.acceptLanguage)
+		}
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, 200, rec.Code, test.name+" failed: status codes do not match!")
+		assert.Equal(t, test.expectedContentLanguage, rec.Header().Get("Content-Language"), test.name+" failed: Content-Language does not match!")
+		assert.Equal(t, []string{"Accept", "Accept-Language"}, rec.Header()["Vary"], test.name+" failed: Vary does not match!")
+		assert.Contains(t, rec.Body.String(), test.expectedPrefLabel, test.name+" failed: prefLabel does not match!")
+		assert.Contains(t, rec.Body.String(), `{"type":"http://www.ft.com/ontology/properName","value":"Nintendo Co., Ltd."}`, test.name+" failed: properName does not match!")
+		assert.Contains(t, rec.Body.String(), `"localisedLabels":{"ja":["任天堂株式会社","任天堂"]}`, test.name+" failed: localisedLabels do not match!")
+		assert.Contains(t, rec.Body.String(), `{"type":"http://www.ft.com/ontology/properName","value":"任天堂株式会社","language":"ja"}`, test.name+" failed: typedLabels do not match!")
+	}
+}
+
 func transformBody(testBody string) string {
 	stripNewLines := strings.Replace(testBody, "\n", "", -1)
 	stripTabs := strings.Replace(stripNewLines, "\t", "", -1)
@@ -490,3 +1052,349 @@ var getTransformedCompleteDeprecatedOrganisation = `{
 	},
 	"isDeprecated":true
 }`
+
+var getOrganisationWithSources = `{
+	"id": "http://www.ft.com/thing/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"apiUrl": "http://api.ft.com/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"type": "http://www.ft.com/ontology/organisation/Organisation",
+	"prefLabel": "Google Inc",
+	"sourceRepresentations": [
+		{
+			"uuid": "d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+			"authority": "Smartlogic",
+			"authorityValue": "d6b12f0c-bf3f-4045-a07b-1e4e49103fd6"
+		},
+		{
+			"uuid": "5c8a1a5d-ad8f-3ac5-8c84-a7e8a3e0e31a",
+			"authority": "FACTSET",
+			"authorityValue": "000C7F-E"
+		}
+	]
+}`
+
+var getTransformedOrganisationWithSources = `{
+	"id":"http://api.ft.com/things/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"apiUrl":"http://api.ft.com/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"prefLabel":"Google Inc",
+	"types":[
+		"http://www.ft.com/ontology/core/Thing",
+		"http://www.ft.com/ontology/concept/Concept",
+		"http://www.ft.com/ontology/organisation/Organisation"
+	],
+	"directType":"http://www.ft.com/ontology/organisation/Organisation",
+	"aliases":["5c8a1a5d-ad8f-3ac5-8c84-a7e8a3e0e31a"],
+	"identifiers":{
+		"FACTSET":["000C7F-E"],
+		"SMARTLOGIC":["d6b12f0c-bf3f-4045-a07b-1e4e49103fd6"]
+	}
+}`
+
+var getTransformedOrganisationWithSourcesAndRedirects = `{
+	"id":"http://api.ft.com/things/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"apiUrl":"http://api.ft.com/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"prefLabel":"Google Inc",
+	"types":[
+		"http://www.ft.com/ontology/core/Thing",
+		"http://www.ft.com/ontology/concept/Concept",
+		"http://www.ft.com/ontology/organisation/Organisation"
+	],
+	"directType":"http://www.ft.com/ontology/organisation/Organisation",
+	"aliases":[
+		"2d3e16e0-61cb-4322-8aff-3b01c59f4daa",
+		"f92a4ca4-84f9-11e8-8f42-da24cd01f044",
+		"5c8a1a5d-ad8f-3ac5-8c84-a7e8a3e0e31a"
+	],
+	"identifiers":{
+		"FACTSET":["000C7F-E"],
+		"SMARTLOGIC":["d6b12f0c-bf3f-4045-a07b-1e4e49103fd6"]
+	}
+}`
+
+var getOrganisationWithBroaderAndNarrower = `{
+	"id": "http://www.ft.com/thing/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"apiUrl": "http://api.ft.com/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"type": "http://www.ft.com/ontology/organisation/Organisation",
+	"prefLabel": "Google Inc",
+	"broaderConcepts": [
+		{
+			"concept": {
+				"id": "http://www.ft.com/thing/335e9e5a-8f2e-11e8-8f42-da24cd01f044",
+				"apiUrl": "http://api.ft.com/concepts/335e9e5a-8f2e-11e8-8f42-da24cd01f044",
+				"type": "http://www.ft.com/ontology/company/PublicCompany",
+				"prefLabel": "Alphabet Inc"
+			},
+			"predicate": "http://www.w3.org/2004/02/skos/core#broader"
+		}
+	],
+	"narrowerConcepts": [
+		{
+			"concept": {
+				"id": "http://www.ft.com/thing/dbb0bdae-1f0c-11e4-b0cb-b2227cce2b54",
+				"apiUrl": "http://api.ft.com/concepts/dbb0bdae-1f0c-11e4-b0cb-b2227cce2b54",
+				"type": "http://www.ft.com/ontology/product/Brand",
+				"prefLabel": "YouTube"
+			},
+			"predicate": "http://www.w3.org/2004/02/skos/core#narrower"
+		}
+	]
+}`
+
+var getTransformedOrganisationWithoutBroaderAndNarrower = `{
+	"id":"http://api.ft.com/things/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"apiUrl":"http://api.ft.com/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"prefLabel":"Google Inc",
+	"types":[
+		"http://www.ft.com/ontology/core/Thing",
+		"http://www.ft.com/ontology/concept/Concept",
+		"http://www.ft.com/ontology/organisation/Organisation"
+	],
+	"directType":"http://www.ft.com/ontology/organisation/Organisation"
+}`
+
+var getTransformedOrganisationWithBroader = `{
+	"id":"http://api.ft.com/things/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"apiUrl":"http://api.ft.com/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"prefLabel":"Google Inc",
+	"types":[
+		"http://www.ft.com/ontology/core/Thing",
+		"http://www.ft.com/ontology/concept/Concept",
+		"http://www.ft.com/ontology/organisation/Organisation"
+	],
+	"directType":"http://www.ft.com/ontology/organisation/Organisation",
+	"broaderConcepts":[
+		{
+			"id":"http://api.ft.com/things/335e9e5a-8f2e-11e8-8f42-da24cd01f044",
+			"apiUrl":"http://api.ft.com/organisations/335e9e5a-8f2e-11e8-8f42-da24cd01f044",
+			"prefLabel":"Alphabet Inc",
+			"types":[
+				"http://www.ft.com/ontology/core/Thing",
+				"http://www.ft.com/ontology/concept/Concept",
+				"http://www.ft.com/ontology/organisation/Organisation",
+				"http://www.ft.com/ontology/company/Company",
+				"http://www.ft.com/ontology/company/PublicCompany"
+			],
+			"directType":"http://www.ft.com/ontology/company/PublicCompany"
+		}
+	]
+}`
+
+var getTransformedOrganisationWithBroaderAndNarrower = `{
+	"id":"http://api.ft.com/things/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"apiUrl":"http://api.ft.com/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"prefLabel":"Google Inc",
+	"types":[
+		"http://www.ft.com/ontology/core/Thing",
+		"http://www.ft.com/ontology/concept/Concept",
+		"http://www.ft.com/ontology/organisation/Organisation"
+	],
+	"directType":"http://www.ft.com/ontology/organisation/Organisation",
+	"broaderConcepts":[
+		{
+			"id":"http://api.ft.com/things/335e9e5a-8f2e-11e8-8f42-da24cd01f044",
+			"apiUrl":"http://api.ft.com/organisations/335e9e5a-8f2e-11e8-8f42-da24cd01f044",
+			"prefLabel":"Alphabet Inc",
+			"types":[
+				"http://www.ft.com/ontology/core/Thing",
+				"http://www.ft.com/ontology/concept/Concept",
+				"http://www.ft.com/ontology/organisation/Organisation",
+				"http://www.ft.com/ontology/company/Company",
+				"http://www.ft.com/ontology/company/PublicCompany"
+			],
+			"directType":"http://www.ft.com/ontology/company/PublicCompany"
+		}
+	],
+	"narrowerConcepts":[
+		{
+			"id":"http://api.ft.com/things/dbb0bdae-1f0c-11e4-b0cb-b2227cce2b54",
+			"apiUrl":"http://api.ft.com/things/dbb0bdae-1f0c-11e4-b0cb-b2227cce2b54",
+			"prefLabel":"YouTube",
+			"types":[
+				"http://www.ft.com/ontology/core/Thing",
+				"http://www.ft.com/ontology/concept/Concept",
+				"http://www.ft.com/ontology/classification/Classification",
+				"http://www.ft.com/ontology/product/Brand"
+			],
+			"directType":"http://www.ft.com/ontology/product/Brand"
+		}
+	]
+}`
+
+var getOrganisationWithOtherPredicates = `{
+	"id": "http://www.ft.com/thing/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"apiUrl": "http://api.ft.com/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"type": "http://www.ft.com/ontology/organisation/Organisation",
+	"prefLabel": "Google Inc",
+	"relatedConcepts": [
+		{
+			"concept": {
+				"id": "http://www.ft.com/thing/dbb0bdae-1f0c-11e4-b0cb-b2227cce2b54",
+				"apiUrl": "http://api.ft.com/concepts/dbb0bdae-1f0c-11e4-b0cb-b2227cce2b54",
+				"type": "http://www.ft.com/ontology/product/Brand",
+				"prefLabel": "YouTube"
+			},
+			"predicate": "http://www.ft.com/ontology/hasBrand"
+		},
+		{
+			"concept": {
+				"id": "http://www.ft.com/thing/335e9e5a-8f2e-11e8-8f42-da24cd01f044",
+				"apiUrl": "http://api.ft.com/concepts/335e9e5a-8f2e-11e8-8f42-da24cd01f044",
+				"type": "http://www.ft.com/ontology/organisation/Organisation",
+				"prefLabel": "Alphabet Inc"
+			},
+			"predicate": "http://www.w3.org/2004/02/skos/core#related"
+		}
+	]
+}`
+
+var getTransformedOrganisationWithBrands = `{
+	"id":"http://api.ft.com/things/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"apiUrl":"http://api.ft.com/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"prefLabel":"Google Inc",
+	"types":[
+		"http://www.ft.com/ontology/core/Thing",
+		"http://www.ft.com/ontology/concept/Concept",
+		"http://www.ft.com/ontology/organisation/Organisation"
+	],
+	"directType":"http://www.ft.com/ontology/organisation/Organisation",
+	"related":{
+		"hasBrand":[
+			{
+				"id":"http://api.ft.com/things/dbb0bdae-1f0c-11e4-b0cb-b2227cce2b54",
+				"apiUrl":"http://api.ft.com/things/dbb0bdae-1f0c-11e4-b0cb-b2227cce2b54",
+				"prefLabel":"YouTube",
+				"types":[
+					"http://www.ft.com/ontology/core/Thing",
+					"http://www.ft.com/ontology/concept/Concept",
+					"http://www.ft.com/ontology/classification/Classification",
+					"http://www.ft.com/ontology/product/Brand"
+				],
+				"directType":"http://www.ft.com/ontology/product/Brand"
+			}
+		]
+	}
+}`
+
+var getTransformedOrganisationWithOtherPredicates = `{
+	"id":"http://api.ft.com/things/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"apiUrl":"http://api.ft.com/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"prefLabel":"Google Inc",
+	"types":[
+		"http://www.ft.com/ontology/core/Thing",
+		"http://www.ft.com/ontology/concept/Concept",
+		"http://www.ft.com/ontology/organisation/Organisation"
+	],
+	"directType":"http://www.ft.com/ontology/organisation/Organisation",
+	"related":{
+		"hasBrand":[
+			{
+				"id":"http://api.ft.com/things/dbb0bdae-1f0c-11e4-b0cb-b2227cce2b54",
+				"apiUrl":"http://api.ft.com/things/dbb0bdae-1f0c-11e4-b0cb-b2227cce2b54",
+				"prefLabel":"YouTube",
+				"types":[
+					"http://www.ft.com/ontology/core/Thing",
+					"http://www.ft.com/ontology/concept/Concept",
+					"http://www.ft.com/ontology/classification/Classification",
+					"http://www.ft.com/ontology/product/Brand"
+				],
+				"directType":"http://www.ft.com/ontology/product/Brand"
+			}
+		],
+		"http://www.w3.org/2004/02/skos/core#related":[
+			{
+				"id":"http://api.ft.com/things/335e9e5a-8f2e-11e8-8f42-da24cd01f044",
+				"apiUrl":"http://api.ft.com/organisations/335e9e5a-8f2e-11e8-8f42-da24cd01f044",
+				"prefLabel":"Alphabet Inc",
+				"types":[
+					"http://www.ft.com/ontology/core/Thing",
+					"http://www.ft.com/ontology/concept/Concept",
+					"http://www.ft.com/ontology/organisation/Organisation"
+				],
+				"directType":"http://www.ft.com/ontology/organisation/Organisation"
+			}
+		]
+	}
+}`
+
+var getOrganisationWithSeveralParents = `{
+	"id": "http://www.ft.com/thing/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"apiUrl": "http://api.ft.com/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"type": "http://www.ft.com/ontology/organisation/Organisation",
+	"prefLabel": "Google Inc",
+	"relatedConcepts": [
+		{
+			"concept": {
+				"id": "http://api.ft.com/things/335e9e5a-8f2e-11e8-8f42-da24cd01f044",
+				"apiUrl": "http://api.ft.com/organisations/335e9e5a-8f2e-11e8-8f42-da24cd01f044",
+				"type": "http://www.ft.com/ontology/organisation/Organisation",
+				"prefLabel": "Alphabet Holdings"
+			},
+			"predicate": "http://www.ft.com/ontology/subOrganisationOf"
+		},
+		{
+			"concept": {
+				"id": "http://api.ft.com/things/1b070fbb-6331-3225-bb57-9108deb67df4",
+				"apiUrl": "http://api.ft.com/organisations/1b070fbb-6331-3225-bb57-9108deb67df4",
+				"type": "http://www.ft.com/ontology/company/PublicCompany",
+				"prefLabel": "Alphabet Inc"
+			},
+			"predicate": "http://www.ft.com/ontology/subOrganisationOf"
+		}
+	]
+}`
+
+var getDeprecatedOrganisationWithSuccessor = `{
+	"id": "http://www.ft.com/thing/6fc8fbac-b4ee-11e8-a790-6c96cfdf3997",
+	"apiUrl": "http://api.ft.com/concepts/6fc8fbac-b4ee-11e8-a790-6c96cfdf3997",
+	"type": "http://www.ft.com/ontology/organisation/Organisation",
+	"prefLabel": "Google Inc",
+	"isDeprecated": true,
+	"relatedConcepts": [
+		{
+			"concept": {
+				"id": "http://www.ft.com/thing/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+				"apiUrl": "http://api.ft.com/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+				"type": "http://www.ft.com/ontology/company/PublicCompany",
+				"prefLabel": "Alphabet Inc"
+			},
+			"predicate": "http://www.ft.com/ontology/isReplacedBy"
+		}
+	]
+}`
+
+var getDatedFormerNameOrganisationAsConcept = `{
+	"id": "http://www.ft.com/thing/7c5218a0-3755-463e-abbc-1a1632cfd1da",
+	"apiUrl": "http://api.ft.com/concepts/7c5218a0-3755-463e-abbc-1a1632cfd1da",
+	"type": "http://www.ft.com/ontology/organisation/Organisation",
+	"prefLabel": "Nintendo Co Ltd",
+	"alternativeLabels": [
+		{
+			"type": "http://www.ft.com/ontology/formerName",
+			"value": "Nintendo Playing Card Co., Ltd.",
+			"validFrom": "1889-09-23",
+			"validTo": "1963-10-01"
+		}
+	]
+}`
+
+var getLocalisedOrganisationAsConcept = `{
+	"id": "http://www.ft.com/thing/7c5218a0-3755-463e-abbc-1a1632cfd1da",
+	"apiUrl": "http://api.ft.com/concepts/7c5218a0-3755-463e-abbc-1a1632cfd1da",
+	"type": "http://www.ft.com/ontology/organisation/Organisation",
+	"prefLabel": "Nintendo Co Ltd",
+	"alternativeLabels": [
+		{
+			"type": "http://www.ft.com/ontology/properName",
+			"value": "Nintendo Co., Ltd."
+		},
+		{
+			"type": "http://www.ft.com/ontology/properName",
+			"value": "任天堂株式会社",
+			"language": "ja"
+		},
+		{
+			"type": "http://www.ft.com/ontology/shortName",
+			"value": "任天堂",
+			"language": "ja"
+		}
+	]
+}`
@@ -0,0 +1,153 @@
+package organisations
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	logger "github.com/Financial-Times/go-logger"
+	transactionidutils "github.com/Financial-Times/transactionid-utils-go"
+)
+
+// GetOrganisationByIdentifier resolves an external authority identifier, e.g.
+// /organisations?authority=FACTSET&identifierValue=000C7F-E, to the canonical organisation
+func (h *OrganisationsHandler) GetOrganisationByIdentifier(w http.ResponseWriter, r *http.Request) {
+	authority := r.URL.Query().Get("authority")
+	identifierValue := r.URL.Query().Get("identifierValue")
+	transID := transactionidutils.GetTransactionIDFromRequest(r)
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if authority == "" || identifierValue == "" {
+		msg := "both authority and identifierValue query parameters are required"
+		logger.WithTransactionID(transID).Error(msg)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(messageJSON(msg))
+		return
+	}
+
+	opts, err := parseRequestOptions(r)
+	if err != nil {
+		logger.WithTransactionID(transID).Error(err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(messageJSON(err.Error()))
+		return
+	}
+	version, acceptable := negotiateVersion(r)
+	w.Header().Add("Vary", "Accept")
+	if !acceptable {
+		w.WriteHeader(http.StatusNotAcceptable)
+		w.Write([]byte(`{"message": "supported media types are ` + v1MediaType + `, ` + v2MediaType + ` and ` + jsonMediaType + `"}`))
+		return
+	}
+
+	uuid, found, err := h.lookupConceptUUID(authority, identifierValue, transID)
+	var organisation Organisation
+	if err == nil && found {
+		organisation, found, err = h.getOrganisation(uuid, transID, opts)
+	}
+	if err != nil {
+		writeFetchError(w, err)
+		return
+	}
+	if !found {
+		setCacheControl(w, h.cachePolicy.NotFound)
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "organisation not found"}`))
+		return
+	}
+
+	canonicalUUID := uuidMatcher.FindString(organisation.ID)
+	if canonicalUUID == "" {
+		logger.WithTransactionID(transID).WithUUID(uuid).Errorf("organisation id '%s' has no uuid", organisation.ID)
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"message": "failed to return organisation"}`))
+		return
+	}
+	canonicalPath := "/organisations/" + canonicalUUID
+	if !resolveAliases(r) {
+		w.Header().Set("Location", canonicalPath)
+		w.Header().Set(surrogateKeyHeader, canonicalUUID)
+		setCacheControl(w, h.cachePolicy.Redirect)
+		w.WriteHeader(http.StatusFound)
+		return
+	}
+	w.Header().Set("Content-Location", canonicalPath)
+	h.writeOrganisation(w, organisation, canonicalUUID, version)
+}
+
+// lookupConceptUUID asks public-concepts-api for the concept concorded to the authority identifier.
+// The concept is either returned directly or redirected to.
+func (h *OrganisationsHandler) lookupConceptUUID(authority string, identifierValue string, transID string) (string, bool, error) {
+	query := url.Values{}
+	query.Set("authority", authority)
+	query.Set("identifierValue", identifierValue)
+	reqURL := h.conceptsURL + "/concepts?" + query.Encode()
+
+	request, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		msg := fmt.Sprintf("failed to create request to %s", reqURL)
+		logger.WithError(err).WithTransactionID(transID).Error(msg)
+		return "", false, err
+	}
+
+	request.Header.Set("X-Request-Id", transID)
+	resp, err := h.client.Do(request)
+	if err != nil {
+		msg := fmt.Sprintf("request to %s was unsuccessful", reqURL)
+		logger.WithError(err).WithTransactionID(transID).Error(msg)
+		return "", false, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return "", false, nil
+	case isRedirect(resp.StatusCode):
+		if uuid := uuidMatcher.FindString(resp.Header.Get("Location")); uuid != "" {
+			return uuid, true, nil
+		}
+		err = newContractViolation(reqURL, fmt.Sprintf("redirect to '%s' has no concept uuid", resp.Header.Get("Location")))
+	case resp.StatusCode != http.StatusOK:
+		err = fmt.Errorf("request to %s returned a non-200 HTTP status: %v", reqURL, resp.StatusCode)
+	default:
+		concept := Concept{}
+		body, readErr := ioutil.ReadAll(resp.Body)
+		if readErr != nil {
+			err = readErr
+		} else if err = json.Unmarshal(body, &concept); err != nil {
+			err = newContractViolation(reqURL, err.Error())
+		} else if uuid := uuidMatcher.FindString(concept.ID); uuid != "" {
+			return uuid, true, nil
+		} else {
+			err = newContractViolation(reqURL, fmt.Sprintf("id '%s' has no concept uuid", concept.ID))
+		}
+	}
+	logger.WithError(err).WithTransactionID(transID).Errorf("failed to look up %s identifier %s", authority, identifierValue)
+	return "", false, err
+}
+
+// identifiersByAuthority groups the identifiers of the source concepts by authority, e.g. FACTSET, TME or WIKIDATA.
+// Authorities are compared regardless of case, and grouped under their upper case spelling.
+func identifiersByAuthority(sources []SourceRepresentation) map[string][]string {
+	identifiers := map[string][]string{}
+	seen := map[string]bool{}
+	for _, source := range sources {
+		if source.Authority == "" || source.AuthorityValue == "" {
+			continue
+		}
+		authority := strings.ToUpper(source.Authority)
+		key := authority + "|" + source.AuthorityValue
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		identifiers[authority] = append(identifiers[authority], source.AuthorityValue)
+	}
+	if len(identifiers) == 0 {
+		return nil
+	}
+	return identifiers
+}
@@ -0,0 +1,81 @@
+package organisations
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	logger "github.com/Financial-Times/go-logger"
+	transactionidutils "github.com/Financial-Times/transactionid-utils-go"
+	"github.com/gorilla/mux"
+)
+
+const industryClassificationPredicate = "/hasIndustryClassification"
+
+// industryClassificationListing is the response listing the organisations with an industry classification
+type industryClassificationListing struct {
+	Organisations []ConceptSummary `json:"organisations"`
+}
+
+// GetOrganisationsByIndustryClassification lists the organisations with an industry classification,
+// e.g. /organisations?industryClassification=38ee195d-ebdd-48a9-af4b-c8a322e7b04d
+func (h *OrganisationsHandler) GetOrganisationsByIndustryClassification(w http.ResponseWriter, r *http.Request) {
+	uuidMatcher := regexp.MustCompile(validUUID)
+	classificationUUID := mux.Vars(r)["classification"]
+	transID := transactionidutils.GetTransactionIDFromRequest(r)
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if !uuidMatcher.MatchString(classificationUUID) {
+		msg := fmt.Sprintf(`industry classification '%s' is not a valid uuid`, classificationUUID)
+		logger.WithTransactionID(transID).WithUUID(classificationUUID).Error(msg)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(messageJSON(msg))
+		return
+	}
+
+	organisations, found, err := h.backend.OrganisationsByIndustryClassification(classificationUUID, transID)
+	if isContractViolation(err) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte(`{"message": "public-concepts-api returned invalid organisations"}`))
+		return
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"message": "failed to return organisations"}`))
+		return
+	}
+	if !found {
+		setCacheControl(w, h.cachePolicy.NotFound)
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "industry classification not found"}`))
+		return
+	}
+
+	setCacheControl(w, h.cachePolicy.OK)
+	keys := []string{classificationUUID}
+	for _, organisation := range organisations {
+		keys = append(keys, uuidMatcher.FindString(organisation.ID))
+	}
+	w.Header().Set(surrogateKeyHeader, strings.Join(keys, " "))
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(industryClassificationListing{organisations}); err != nil {
+		logger.WithError(err).WithTransactionID(transID).Error("failed to write organisations")
+	}
+}
+
+func newIndustryClassification(c Concept) IndustryClassification {
+	return IndustryClassification{
+		ID:     convertID(c.ID),
+		Code:   c.IndustryIdentifier,
+		Label:  c.PrefLabel,
+		Scheme: classificationScheme(c.Type),
+	}
+}
+
+// classificationScheme names the scheme of a classification type, e.g. http://www.ft.com/ontology/NAICSIndustryClassification is NAICS
+func classificationScheme(conceptType string) string {
+	name := conceptType[strings.LastIndex(conceptType, "/")+1:]
+	return strings.TrimSuffix(name, "IndustryClassification")
+}
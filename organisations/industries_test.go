@@ -0,0 +1,135 @@
+package organisations
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockBackend struct {
+	organisations []ConceptSummary
+	found         bool
+	err           error
+}
+
+func (b *mockBackend) OrganisationsByIndustryClassification(classificationUUID string, transID string) ([]ConceptSummary, bool, error) {
+	return b.organisations, b.found, b.err
+}
+
+func TestIndustryClassificationsAreMapped(t *testing.T) {
+	mockClient := &mockHTTPClient{resp: getOrganisationWithIndustryClassification, statusCode: 200}
+	bh := NewHandler(mockClient, "")
+
+	org, found, err := bh.getOrganisationViaConceptsAPI(context.Background(), "d6b12f0c-bf3f-4045-a07b-1e4e49103fd6", "tid_test", requestOptions{})
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []IndustryClassification{
+		{ID: "http://api.ft.com/things/38ee195d-ebdd-48a9-af4b-c8a322e7b04d", Code: "519130", Label: "Internet Publishing and Broadcasting and Web Search Portals", Scheme: "NAICS"},
+	}, org.IndustryClassifications)
+	assert.Nil(t, org.Related, "industry classifications should not be repeated in the related section")
+}
+
+func TestGetOrganisationsByIndustryClassification(t *testing.T) {
+	mockClient := &mockRoutingHTTPClient{responses: map[string]mockResponse{
+		"/concepts?industryClassification=38ee195d-ebdd-48a9-af4b-c8a322e7b04d&type=http%3A%2F%2Fwww.ft.com%2Fontology%2Forganisation%2FOrganisation": {statusCode: 200, body: getOrganisationsSearchResponse},
+	}}
+	router := mux.NewRouter()
+	bh := NewHandler(mockClient, "")
+	bh.RegisterHandlers(router)
+
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/organisations?industryClassification=38ee195d-ebdd-48a9-af4b-c8a322e7b04d", nil)
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, "38ee195d-ebdd-48a9-af4b-c8a322e7b04d d6b12f0c-bf3f-4045-a07b-1e4e49103fd6", rec.Header().Get("Surrogate-Key"))
+	assert.Equal(t, transformBody(getTransformedOrganisationsByIndustryClassification), rec.Body.String())
+}
+
+func TestGetOrganisationsByIndustryClassificationErrors(t *testing.T) {
+	testCases := []struct {
+		name         string
+		url          string
+		backend      *mockBackend
+		expectedCode int
+		expectedBody string
+	}{
+		{"Invalid uuid", "/organisations?industryClassification=519130", &mockBackend{}, 400, `{"message": "industry classification '519130' is not a valid uuid"}`},
+		{"Not found", "/organisations?industryClassification=38ee195d-ebdd-48a9-af4b-c8a322e7b04d", &mockBackend{}, 404, `{"message": "industry classification not found"}`},
+		{"Backend error", "/organisations?industryClassification=38ee195d-ebdd-48a9-af4b-c8a322e7b04d", &mockBackend{err: errors.New("Downstream error")}, 500, `{"message": "failed to return organisations"}`},
+		{"No organisations", "/organisations?industryClassification=38ee195d-ebdd-48a9-af4b-c8a322e7b04d", &mockBackend{organisations: []ConceptSummary{}, found: true}, 200, "{\"organisations\":[]}\n"},
+	}
+
+	for _, test := range testCases {
+		router := mux.NewRouter()
+		bh := NewHandler(&mockHTTPClient{}, "")
+		bh.UseBackend(test.backend)
+		bh.RegisterHandlers(router)
+
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", test.url, nil)
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, test.expectedCode, rec.Code, test.name+" failed: status codes do not match!")
+		assert.Equal(t, test.expectedBody, rec.Body.String(), test.name+" failed: status body does not match!")
+	}
+}
+
+var getOrganisationWithIndustryClassification = `{
+	"id": "http://www.ft.com/thing/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"apiUrl": "http://api.ft.com/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+	"type": "http://www.ft.com/ontology/organisation/Organisation",
+	"prefLabel": "Google Inc",
+	"relatedConcepts": [
+		{
+			"concept": {
+				"id": "http://www.ft.com/thing/38ee195d-ebdd-48a9-af4b-c8a322e7b04d",
+				"apiUrl": "http://api.ft.com/concepts/38ee195d-ebdd-48a9-af4b-c8a322e7b04d",
+				"type": "http://www.ft.com/ontology/NAICSIndustryClassification",
+				"prefLabel": "Internet Publishing and Broadcasting and Web Search Portals",
+				"industryIdentifier": "519130"
+			},
+			"predicate": "http://www.ft.com/ontology/hasIndustryClassification"
+		}
+	]
+}`
+
+var getOrganisationsSearchResponse = `{
+	"concepts": [
+		{
+			"id": "http://www.ft.com/thing/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+			"apiUrl": "http://api.ft.com/concepts/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+			"type": "http://www.ft.com/ontology/company/PublicCompany",
+			"prefLabel": "Google Inc"
+		},
+		{
+			"id": "http://www.ft.com/thing/f92a4ca4-84f9-11e8-8f42-da24cd01f044",
+			"apiUrl": "http://api.ft.com/concepts/f92a4ca4-84f9-11e8-8f42-da24cd01f044",
+			"type": "http://www.ft.com/ontology/person/Person",
+			"prefLabel": "Not a organisation"
+		}
+	]
+}`
+
+var getTransformedOrganisationsByIndustryClassification = `{
+	"organisations":[
+		{
+			"id":"http://api.ft.com/things/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+			"apiUrl":"http://api.ft.com/organisations/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6",
+			"prefLabel":"Google Inc",
+			"types":[
+				"http://www.ft.com/ontology/core/Thing",
+				"http://www.ft.com/ontology/concept/Concept",
+				"http://www.ft.com/ontology/organisation/Organisation",
+				"http://www.ft.com/ontology/company/Company",
+				"http://www.ft.com/ontology/company/PublicCompany"
+			],
+			"directType":"http://www.ft.com/ontology/company/PublicCompany"
+		}
+	]
+}`
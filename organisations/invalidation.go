@@ -0,0 +1,173 @@
+package organisations
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	logger "github.com/Financial-Times/go-logger"
+	transactionidutils "github.com/Financial-Times/transactionid-utils-go"
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+var errInvalidChangeUUID = errors.New("concept change has a missing or invalid uuid")
+
+// droppedChanges counts the concept changes POSTed while the invalidator was too far behind to accept them
+var droppedChanges = metrics.GetOrRegisterCounter("invalidation.dropped-changes", metrics.DefaultRegistry)
+
+// ConceptChange notifies that a concept, and possibly some concepts related to it, have changed
+type ConceptChange struct {
+	UUID         string   `json:"uuid"`
+	RelatedUUIDs []string `json:"relatedUUIDs,omitempty"`
+}
+
+// ChangeSource delivers concept change notifications. The channel is closed once the source is exhausted.
+type ChangeSource interface {
+	Changes() <-chan ConceptChange
+}
+
+// Invalidator evicts cached organisations affected by concept changes
+type Invalidator struct {
+	cache *Cache
+}
+
+func NewInvalidator(cache *Cache) *Invalidator {
+	return &Invalidator{cache}
+}
+
+// Invalidate evicts the changed concept, its related concepts and every cached organisation
+// whose embedded parent, subsidiaries or financial instrument reference any of them
+func (i *Invalidator) Invalidate(change ConceptChange) int {
+	uuids := append([]string{change.UUID}, change.RelatedUUIDs...)
+	evicted := i.cache.Evict(uuids...)
+	logger.WithField("uuid", change.UUID).Debugf("evicted %d cached organisations", evicted)
+	return evicted
+}
+
+// Listen invalidates the cache for every change delivered by the source, until the source is exhausted
+func (i *Invalidator) Listen(source ChangeSource) {
+	for change := range source.Changes() {
+		i.Invalidate(change)
+	}
+}
+
+// ReaderChangeSource reads newline delimited JSON concept changes, e.g. from a file or stdin.
+// A line holding only a UUID is accepted as a change without related concepts.
+type ReaderChangeSource struct {
+	changes chan ConceptChange
+}
+
+func NewReaderChangeSource(r io.Reader) *ReaderChangeSource {
+	s := &ReaderChangeSource{make(chan ConceptChange)}
+	go s.read(r)
+	return s
+}
+
+func (s *ReaderChangeSource) Changes() <-chan ConceptChange {
+	return s.changes
+}
+
+func (s *ReaderChangeSource) read(r io.Reader) {
+	defer close(s.changes)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		change, err := parseConceptChange(line)
+		if err != nil {
+			logger.WithError(err).Warnf("ignoring invalid concept change: %s", line)
+			continue
+		}
+		s.changes <- change
+	}
+	if err := scanner.Err(); err != nil {
+		logger.WithError(err).Error("failed to read concept changes")
+	}
+}
+
+func parseConceptChange(line string) (ConceptChange, error) {
+	change := ConceptChange{}
+	if !strings.HasPrefix(line, "{") {
+		change.UUID = line
+	} else if err := json.Unmarshal([]byte(line), &change); err != nil {
+		return change, err
+	}
+	if !isUUID(change.UUID) {
+		return change, errInvalidChangeUUID
+	}
+	return change, nil
+}
+
+// HTTPChangeSource accepts concept changes POSTed as a JSON object or array of objects. Changes are queued without
+// waiting for the invalidator: when the queue is full they are dropped, counted, and the request is answered with a 503.
+type HTTPChangeSource struct {
+	changes chan ConceptChange
+}
+
+func NewHTTPChangeSource() *HTTPChangeSource {
+	return &HTTPChangeSource{make(chan ConceptChange, 64)}
+}
+
+func (s *HTTPChangeSource) Changes() <-chan ConceptChange {
+	return s.changes
+}
+
+func (s *HTTPChangeSource) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	transID := transactionidutils.GetTransactionIDFromRequest(r)
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		logger.WithError(err).WithTransactionID(transID).Error("failed to read concept changes")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"message": "failed to read request body"}`))
+		return
+	}
+
+	changes := []ConceptChange{}
+	trimmed := strings.TrimSpace(string(body))
+	if strings.HasPrefix(trimmed, "[") {
+		err = json.Unmarshal(body, &changes)
+	} else {
+		change := ConceptChange{}
+		err = json.Unmarshal(body, &change)
+		changes = append(changes, change)
+	}
+	if err == nil {
+		for _, change := range changes {
+			if !isUUID(change.UUID) {
+				err = errInvalidChangeUUID
+				break
+			}
+		}
+	}
+	if err != nil {
+		logger.WithError(err).WithTransactionID(transID).Warn("rejecting invalid concept changes")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"message": "invalid concept change"}`))
+		return
+	}
+
+	dropped := 0
+	for _, change := range changes {
+		select {
+		case s.changes <- change:
+		default:
+			dropped++
+		}
+	}
+	if dropped > 0 {
+		droppedChanges.Inc(int64(dropped))
+		logger.WithTransactionID(transID).Warnf("dropped %d of %d concept changes as the invalidation queue is full", dropped, len(changes))
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"message": "invalidation queue is full"}`))
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
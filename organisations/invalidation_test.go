@@ -0,0 +1,93 @@
+package organisations
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReaderChangeSourceInvalidatesCache(t *testing.T) {
+	cache := NewCache(time.Minute, 100)
+	cache.Set(cachedOrganisationUUID, cachedOrganisation())
+	cache.Set(cachedParentUUID, Organisation{Thing: Thing{ID: thingsApiUrl + cachedParentUUID}})
+	cache.Set("f92a4ca4-84f9-11e8-8f42-da24cd01f044", Organisation{})
+
+	input := strings.Join([]string{
+		`{"uuid": "` + cachedParentUUID + `"}`,
+		`not a change`,
+		``,
+		`{"uuid": "6fc8fbac-b4ee-11e8-a790-6c96cfdf3997", "relatedUUIDs": ["f92a4ca4-84f9-11e8-8f42-da24cd01f044"]}`,
+	}, "\n")
+
+	NewInvalidator(cache).Listen(NewReaderChangeSource(strings.NewReader(input)))
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestReaderChangeSourceAcceptsBareUUIDs(t *testing.T) {
+	source := NewReaderChangeSource(strings.NewReader(cachedOrganisationUUID + "\n"))
+
+	changes := []ConceptChange{}
+	for change := range source.Changes() {
+		changes = append(changes, change)
+	}
+	assert.Equal(t, []ConceptChange{{UUID: cachedOrganisationUUID}}, changes)
+}
+
+func TestHTTPChangeSource(t *testing.T) {
+	testCases := []struct {
+		name         string
+		body         string
+		expectedCode int
+		expected     []ConceptChange
+	}{
+		{
+			"single change",
+			`{"uuid": "` + cachedOrganisationUUID + `", "relatedUUIDs": ["` + cachedParentUUID + `"]}`,
+			http.StatusAccepted,
+			[]ConceptChange{{UUID: cachedOrganisationUUID, RelatedUUIDs: []string{cachedParentUUID}}},
+		},
+		{
+			"list of changes",
+			`[{"uuid": "` + cachedOrganisationUUID + `"}, {"uuid": "` + cachedParentUUID + `"}]`,
+			http.StatusAccepted,
+			[]ConceptChange{{UUID: cachedOrganisationUUID}, {UUID: cachedParentUUID}},
+		},
+		{"invalid uuid", `{"uuid": "1234"}`, http.StatusBadRequest, nil},
+		{"invalid json", `{`, http.StatusBadRequest, nil},
+	}
+
+	for _, test := range testCases {
+		source := NewHTTPChangeSource()
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/__invalidate", strings.NewReader(test.body))
+		source.ServeHTTP(rec, req)
+
+		assert.Equal(t, test.expectedCode, rec.Code, test.name+" failed: status codes do not match!")
+		var received []ConceptChange
+		for len(source.changes) > 0 {
+			received = append(received, <-source.changes)
+		}
+		assert.Equal(t, test.expected, received, test.name+" failed: changes do not match!")
+	}
+}
+
+func TestHTTPChangeSourceDropsChangesWhenFull(t *testing.T) {
+	source := NewHTTPChangeSource()
+	for i := 0; i < cap(source.changes); i++ {
+		source.changes <- ConceptChange{UUID: cachedParentUUID}
+	}
+	dropped := droppedChanges.Count()
+
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/__invalidate", strings.NewReader(`{"uuid": "`+cachedOrganisationUUID+`"}`))
+	source.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code, "a full queue should not block the request")
+	assert.Equal(t, `{"message": "invalidation queue is full"}`, rec.Body.String())
+	assert.Equal(t, int64(1), droppedChanges.Count()-dropped)
+	assert.Len(t, source.changes, cap(source.changes))
+}
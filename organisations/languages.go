@@ -0,0 +1,127 @@
+package organisations
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// defaultLanguage is the language of the prefLabel returned by public-concepts-api
+const defaultLanguage = "en"
+
+// prefLabelTypes are the label types usable as a localised prefLabel, best first
+var prefLabelTypes = []string{"/prefLabel", "/properName", "/shortName"}
+
+// languageRange is one language of an Accept-Language header with its weight
+type languageRange struct {
+	tag     string
+	quality float64
+}
+
+// localisedLabels groups the labels that have a language tag by lower case language, de-duplicated per language.
+// It also returns the best label of each language to use as prefLabel.
+func localisedLabels(labels []TypedValue) (map[string][]string, map[string]string) {
+	byLanguage := map[string][]string{}
+	prefLabels := map[string]string{}
+	prefRanks := map[string]int{}
+	seen := map[string]bool{}
+	for _, label := range labels {
+		if label.Language == "" {
+			continue
+		}
+		language := strings.ToLower(label.Language)
+		key := language + "|" + label.Value
+		if !seen[key] {
+			seen[key] = true
+			byLanguage[language] = append(byLanguage[language], label.Value)
+		}
+
+		labelType := strings.TrimPrefix(label.Type, ontologyPrefix)
+		for rank, prefLabelType := range prefLabelTypes {
+			if labelType != prefLabelType {
+				continue
+			}
+			if current, found := prefRanks[language]; !found || rank < current {
+				prefRanks[language] = rank
+				prefLabels[language] = label.Value
+			}
+		}
+	}
+	if len(byLanguage) == 0 {
+		return nil, nil
+	}
+	return byLanguage, prefLabels
+}
+
+// parseAcceptLanguage reads the language ranges of an Accept-Language header, most preferred first.
+// Ranges with a zero or invalid weight are left out.
+func parseAcceptLanguage(header string) []languageRange {
+	ranges := []languageRange{}
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.TrimSpace(fields[0])
+		if tag == "" {
+			continue
+		}
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64)
+				if err != nil {
+					q = 0
+				}
+				quality = q
+			}
+		}
+		if quality > 0 {
+			ranges = append(ranges, languageRange{tag: tag, quality: quality})
+		}
+	}
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+	return ranges
+}
+
+// localisePrefLabel replaces the prefLabel with the label in the best available language and returns that language.
+// Ranges match a language exactly or by prefix, e.g. fr matches fr-FR and fr-CA matches fr; the default language is
+// used when none match.
+func localisePrefLabel(organisation *Organisation, ranges []languageRange) string {
+	for _, r := range ranges {
+		if r.tag == "*" || matchesLanguage(r.tag, defaultLanguage) {
+			return defaultLanguage
+		}
+		if language, found := bestLanguage(r.tag, organisation.localisedPrefLabels); found {
+			organisation.PrefLabel = organisation.localisedPrefLabels[language]
+			return language
+		}
+	}
+	return defaultLanguage
+}
+
+// bestLanguage finds the language matching the range, preferring an exact match to a prefix match
+func bestLanguage(tag string, prefLabels map[string]string) (string, bool) {
+	languages := make([]string, 0, len(prefLabels))
+	for language := range prefLabels {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	for _, language := range languages {
+		if strings.EqualFold(language, tag) {
+			return language, true
+		}
+	}
+	for _, language := range languages {
+		if matchesLanguage(tag, language) {
+			return language, true
+		}
+	}
+	return "", false
+}
+
+// matchesLanguage tells whether one language tag is the other or a prefix of it
+func matchesLanguage(a string, b string) bool {
+	a, b = strings.ToLower(a), strings.ToLower(b)
+	return a == b || strings.HasPrefix(a, b+"-") || strings.HasPrefix(b, a+"-")
+}
@@ -0,0 +1,76 @@
+package organisations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	ranges := parseAcceptLanguage("fr-CH, fr;q=0.9, en;q=0.8, de;q=0.7, *;q=0.5, ja;q=0, es;q=x")
+	assert.Equal(t, []languageRange{
+		{tag: "fr-CH", quality: 1},
+		{tag: "fr", quality: 0.9},
+		{tag: "en", quality: 0.8},
+		{tag: "de", quality: 0.7},
+		{tag: "*", quality: 0.5},
+	}, ranges)
+
+	assert.Empty(t, parseAcceptLanguage(""))
+}
+
+func TestLocalisedLabels(t *testing.T) {
+	labels := []TypedValue{
+		{Type: "http://www.ft.com/ontology/hiddenLabel", Value: "NINTENDO", Language: "ja"},
+		{Type: "http://www.ft.com/ontology/shortName", Value: "任天堂", Language: "ja"},
+		{Type: "http://www.ft.com/ontology/properName", Value: "任天堂株式会社", Language: "ja"},
+		{Type: "http://www.ft.com/ontology/shortName", Value: "任天堂", Language: "ja"},
+		{Type: "http://www.ft.com/ontology/hiddenLabel", Value: "Nintendo SA", Language: "fr"},
+		{Type: "http://www.ft.com/ontology/properName", Value: "Nintendo Co., Ltd."},
+	}
+	byLanguage, prefLabels := localisedLabels(labels)
+	assert.Equal(t, map[string][]string{"ja": {"NINTENDO", "任天堂", "任天堂株式会社"}, "fr": {"Nintendo SA"}}, byLanguage)
+	assert.Equal(t, map[string]string{"ja": "任天堂株式会社"}, prefLabels)
+
+	byLanguage, prefLabels = localisedLabels(labels[5:])
+	assert.Nil(t, byLanguage)
+	assert.Nil(t, prefLabels)
+}
+
+func TestLocalisedLabelsIgnoreLanguageCase(t *testing.T) {
+	labels := []TypedValue{
+		{Type: "http://www.ft.com/ontology/shortName", Value: "Nintendo France", Language: "fr-FR"},
+		{Type: "http://www.ft.com/ontology/shortName", Value: "Nintendo France", Language: "fr-fr"},
+		{Type: "http://www.ft.com/ontology/properName", Value: "Nintendo France SARL", Language: "FR-fr"},
+	}
+	byLanguage, prefLabels := localisedLabels(labels)
+	assert.Equal(t, map[string][]string{"fr-fr": {"Nintendo France", "Nintendo France SARL"}}, byLanguage)
+	assert.Equal(t, map[string]string{"fr-fr": "Nintendo France SARL"}, prefLabels)
+}
+
+func TestLocalisePrefLabel(t *testing.T) {
+	testCases := []struct {
+		name             string
+		acceptLanguage   string
+		expectedLanguage string
+		expectedLabel    string
+	}{
+		{"Exact match", "ja", "ja", "任天堂株式会社"},
+		{"Prefix match", "ja-JP", "ja", "任天堂株式会社"},
+		{"Region of a range", "fr", "fr-FR", "Nintendo France"},
+		{"Preferred over default", "de, ja;q=0.9, en;q=0.5", "ja", "任天堂株式会社"},
+		{"Default preferred", "en, ja;q=0.9", "en", "Nintendo Co Ltd"},
+		{"Wildcard", "de, *;q=0.1", "en", "Nintendo Co Ltd"},
+		{"No match", "de", "en", "Nintendo Co Ltd"},
+	}
+
+	for _, test := range testCases {
+		org := Organisation{
+			Thing:               Thing{PrefLabel: "Nintendo Co Ltd"},
+			localisedPrefLabels: map[string]string{"ja": "任天堂株式会社", "fr-FR": "Nintendo France"},
+		}
+		language := localisePrefLabel(&org, parseAcceptLanguage(test.acceptLanguage))
+		assert.Equal(t, test.expectedLanguage, language, test.name+" failed: languages do not match!")
+		assert.Equal(t, test.expectedLabel, org.PrefLabel, test.name+" failed: labels do not match!")
+	}
+}
@@ -30,22 +30,40 @@ public class Organisation extends Thing {
 */
 type Organisation struct {
 	Thing
-	ProperName             string               `json:"properName,omitempty"`
-	ShortName              string               `json:"shortName,omitempty"`
-	HiddenLabel            string               `json:"hiddenLabel,omitempty"`
-	FormerNames            []string             `json:"formerNames,omitempty"`
-	CountryCode            string               `json:"countryCode,omitempty"`
-	CountryOfIncorporation string               `json:"countryOfIncorporation,omitempty"`
-	PostalCode             string               `json:"postalCode,omitempty"`
-	YearFounded            int                  `json:"yearFounded,omitempty"`
-	Types                  []string             `json:"types"`
-	DirectType             string               `json:"directType,omitempty"`
-	Labels                 []string             `json:"labels,omitempty"`
-	LegalEntityIdentifier  string               `json:"leiCode,omitempty"`
-	Parent                 *Parent              `json:"parentOrganisation,omitempty"`
-	Subsidiaries           []Subsidiary         `json:"subsidiaries,omitempty"`
-	FinancialInstrument    *FinancialInstrument `json:"financialInstrument,omitempty"`
-	IsDeprecated           bool                 `json:"isDeprecated,omitempty"`
+	ProperName                    string                      `json:"properName,omitempty"`
+	ShortName                     string                      `json:"shortName,omitempty"`
+	HiddenLabel                   string                      `json:"hiddenLabel,omitempty"`
+	FormerNames                   []string                    `json:"formerNames,omitempty"`
+	FormerNameHistory             []FormerName                `json:"formerNameHistory,omitempty"`
+	PrefLabelAtDate               string                      `json:"prefLabelAtDate,omitempty"`
+	CountryCode                   string                      `json:"countryCode,omitempty"`
+	CountryOfIncorporation        string                      `json:"countryOfIncorporation,omitempty"`
+	CountryCodeDetails            *Country                    `json:"countryCodeDetails,omitempty"`
+	CountryOfIncorporationDetails *Country                    `json:"countryOfIncorporationDetails,omitempty"`
+	PostalCode                    string                      `json:"postalCode,omitempty"`
+	YearFounded                   int                         `json:"yearFounded,omitempty"`
+	Types                         []string                    `json:"types"`
+	DirectType                    string                      `json:"directType,omitempty"`
+	Labels                        []string                    `json:"labels,omitempty"`
+	LocalisedLabels               map[string][]string         `json:"localisedLabels,omitempty"`
+	TypedLabels                   []TypedValue                `json:"typedLabels,omitempty"`
+	Aliases                       []string                    `json:"aliases,omitempty"`
+	Identifiers                   map[string][]string         `json:"identifiers,omitempty"`
+	LegalEntityIdentifier         string                      `json:"leiCode,omitempty"`
+	Parent                        *Parent                     `json:"parentOrganisation,omitempty"`
+	ParentOrganisations           []Parent                    `json:"parentOrganisations,omitempty"`
+	Subsidiaries                  []Subsidiary                `json:"subsidiaries,omitempty"`
+	FinancialInstrument           *FinancialInstrument        `json:"financialInstrument,omitempty"`
+	FinancialInstruments          []FinancialInstrument       `json:"financialInstruments,omitempty"`
+	BroaderConcepts               []ConceptSummary            `json:"broaderConcepts,omitempty"`
+	NarrowerConcepts              []ConceptSummary            `json:"narrowerConcepts,omitempty"`
+	Related                       map[string][]ConceptSummary `json:"related,omitempty"`
+	IndustryClassifications       []IndustryClassification    `json:"industryClassifications,omitempty"`
+	ReplacedBy                    *ConceptSummary             `json:"replacedBy,omitempty"`
+	IsDeprecated                  bool                        `json:"isDeprecated,omitempty"`
+
+	// localisedPrefLabels are the labels usable as prefLabel in each language, used for Accept-Language negotiation
+	localisedPrefLabels map[string]string
 }
 
 // Parent is a simplified representation of a parent organisation, used in Organisation API
@@ -62,6 +80,21 @@ type Subsidiary struct {
 	DirectType string   `json:"directType,omitempty"`
 }
 
+// ConceptSummary is a simplified representation of a broader, narrower or related concept, used in Organisation API
+type ConceptSummary struct {
+	Thing
+	Types      []string `json:"types,omitempty"`
+	DirectType string   `json:"directType,omitempty"`
+}
+
+// IndustryClassification is the classification of an organisation in an industry classification scheme, such as NAICS
+type IndustryClassification struct {
+	ID     string `json:"id"`
+	Code   string `json:"code,omitempty"`
+	Label  string `json:"label,omitempty"`
+	Scheme string `json:"scheme,omitempty"`
+}
+
 type FinancialInstrument struct {
 	Thing
 	Types      []string `json:"types,omitempty"`
@@ -70,29 +103,40 @@ type FinancialInstrument struct {
 }
 
 type TypedValue struct {
-	Type  string `json:"type"`
-	Value string `json:"value"`
+	Type      string `json:"type"`
+	Value     string `json:"value"`
+	ValidFrom string `json:"validFrom,omitempty"`
+	ValidTo   string `json:"validTo,omitempty"`
+	Language  string `json:"language,omitempty"`
 }
 
 type ConceptApiResponse struct {
 	Concept
-	DescriptionXML         string           `json:"descriptionXML,omitempty"`
-	Strapline              string           `json:"strapline,omitempty"`
-	Broader                []RelatedConcept `json:"broaderConcepts,omitempty"`
-	Narrower               []RelatedConcept `json:"narrowerConcepts,omitempty"`
-	Related                []RelatedConcept `json:"relatedConcepts,omitempty"`
-	CountryCode            string           `json:"countryCode,omitempty"`
-	CountryOfIncorporation string           `json:"countryOfIncorporation,omitempty"`
-	LeiCode                string           `json:"leiCode,omitempty"`
-	PostalCode             string           `json:"postalCode,omitempty"`
-	YearFounded            int              `json:"yearFounded,omitempty"`
-	AlternativeLabels      []TypedValue     `json:"alternativeLabels,omitempty"`
-	IsDeprecated           bool             `json:"isDeprecated,omitempty"`
+	DescriptionXML         string                 `json:"descriptionXML,omitempty"`
+	Strapline              string                 `json:"strapline,omitempty"`
+	Broader                []RelatedConcept       `json:"broaderConcepts,omitempty"`
+	Narrower               []RelatedConcept       `json:"narrowerConcepts,omitempty"`
+	Related                []RelatedConcept       `json:"relatedConcepts,omitempty"`
+	CountryCode            string                 `json:"countryCode,omitempty"`
+	CountryOfIncorporation string                 `json:"countryOfIncorporation,omitempty"`
+	LeiCode                string                 `json:"leiCode,omitempty"`
+	PostalCode             string                 `json:"postalCode,omitempty"`
+	YearFounded            int                    `json:"yearFounded,omitempty"`
+	AlternativeLabels      []TypedValue           `json:"alternativeLabels,omitempty"`
+	IsDeprecated           bool                   `json:"isDeprecated,omitempty"`
+	SourceRepresentations  []SourceRepresentation `json:"sourceRepresentations,omitempty"`
+}
+
+// SourceRepresentation is one of the source concepts concorded to a canonical concept
+type SourceRepresentation struct {
+	UUID           string `json:"uuid"`
+	Authority      string `json:"authority,omitempty"`
+	AuthorityValue string `json:"authorityValue,omitempty"`
 }
 
 type RelatedConcept struct {
-	Concept   Concept `json:concept,omitempty`
-	Predicate string  `json:predicate,omitempty`
+	Concept   Concept `json:"concept,omitempty"`
+	Predicate string  `json:"predicate,omitempty"`
 }
 
 type Concept struct {
@@ -101,4 +145,6 @@ type Concept struct {
 	PrefLabel string `json:"prefLabel,omitempty"`
 	Type      string `json:"type,omitempty"`
 	Figi      string `json:"figiCode,omitempty"`
+	// IndustryIdentifier is the code of an industry classification concept
+	IndustryIdentifier string `json:"industryIdentifier,omitempty"`
 }
@@ -0,0 +1,122 @@
+package organisations
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// requestOptions are the choices a client makes about what an organisation response includes
+type requestOptions struct {
+	broader  bool
+	narrower bool
+	// relationships restricts the related section to these predicates; every predicate is included if it is empty
+	relationships []string
+	// expandCountry adds the details of the country codes, without changing what is asked of public-concepts-api
+	expandCountry bool
+	// nameAt asks for the name valid on a date as prefLabelAtDate; it is zero when no date was given
+	nameAt time.Time
+}
+
+// parseRequestOptions reads the options from the query, e.g. ?include=broader,narrower&relationships=hasBrand&expand=country&nameAt=2015-06-01
+func parseRequestOptions(r *http.Request) (requestOptions, error) {
+	opts := requestOptions{}
+	if nameAt := r.URL.Query().Get("nameAt"); nameAt != "" {
+		date, err := time.Parse(nameAtLayout, nameAt)
+		if err != nil {
+			return opts, fmt.Errorf("nameAt '%s' is not a date in the YYYY-MM-DD format", nameAt)
+		}
+		opts.nameAt = date
+	}
+
+	opts.include(queryValues(r.URL.Query(), "include"), queryValues(r.URL.Query(), "expand"))
+
+	seen := map[string]bool{}
+	for _, relationship := range queryValues(r.URL.Query(), "relationships") {
+		relationship = predicateName(relationship)
+		if !seen[relationship] {
+			seen[relationship] = true
+			opts.relationships = append(opts.relationships, relationship)
+		}
+	}
+	sort.Strings(opts.relationships)
+	return opts, nil
+}
+
+// include sets the options asked for by include values, e.g. broader and narrower, and by expand values, e.g. country
+func (o *requestOptions) include(includes []string, expands []string) {
+	for _, include := range includes {
+		switch include {
+		case "broader":
+			o.broader = true
+		case "narrower":
+			o.narrower = true
+		}
+	}
+
+	for _, expand := range expands {
+		if expand == "country" {
+			o.expandCountry = true
+		}
+	}
+}
+
+// includesRelationship tells whether concepts related by the predicate belong in the related section
+func (o requestOptions) includesRelationship(predicate string) bool {
+	if len(o.relationships) == 0 {
+		return true
+	}
+	for _, relationship := range o.relationships {
+		if relationship == predicate {
+			return true
+		}
+	}
+	return false
+}
+
+// queryValues splits comma separated and repeated query parameters into a single list
+func queryValues(query url.Values, name string) []string {
+	values := []string{}
+	for _, param := range query[name] {
+		for _, value := range strings.Split(param, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+// showRelationships lists the relationships requested from public-concepts-api
+func (o requestOptions) showRelationships() []string {
+	relationships := []string{"related"}
+	if o.broader {
+		relationships = append(relationships, "broader")
+	}
+	if o.narrower {
+		relationships = append(relationships, "narrower")
+	}
+	for _, relationship := range o.relationships {
+		if relationship != "related" && relationship != "broader" && relationship != "narrower" {
+			relationships = append(relationships, relationship)
+		}
+	}
+	return relationships
+}
+
+// conceptsQuery is the query string used to retrieve a concept from public-concepts-api
+func (o requestOptions) conceptsQuery() string {
+	query := []string{}
+	for _, relationship := range o.showRelationships() {
+		query = append(query, "showRelationship="+url.QueryEscape(relationship))
+	}
+	return "?" + strings.Join(query, "&")
+}
+
+// cacheKey identifies the organisation requested with these options in the cache
+func (o requestOptions) cacheKey(uuid string) string {
+	return uuid + o.conceptsQuery()
+}
@@ -0,0 +1,13 @@
+package organisations
+
+import (
+	"strings"
+)
+
+const surrogateKeyHeader = "Surrogate-Key"
+
+// surrogateKeys lists the canonical UUID of the organisation, its aliases and the UUIDs of every concept
+// embedded in the organisation, so that a change to any of them can purge the response
+func surrogateKeys(organisation Organisation) string {
+	return strings.Join(referencedUUIDs(organisation), " ")
+}
@@ -0,0 +1,105 @@
+package organisations
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// contractViolations counts the public-concepts-api responses that do not follow its contract
+var contractViolations = metrics.GetOrRegisterCounter("public-concepts-api.contract-violations", metrics.DefaultRegistry)
+
+// thingIDMatcher matches concept ids, which public-concepts-api gives as either thing URI
+var thingIDMatcher = regexp.MustCompile("^(" + regexp.QuoteMeta(ftThing) + "|" + regexp.QuoteMeta(thingsApiUrl) + ")" + uuidMatcher.String() + "$")
+
+// contractViolation is a public-concepts-api response that cannot be mapped because it does not follow the contract of
+// public-concepts-api, as opposed to a concept that is not found or a request that failed
+type contractViolation struct {
+	url      string
+	problems []string
+}
+
+func newContractViolation(url string, problems ...string) *contractViolation {
+	contractViolations.Inc(1)
+	return &contractViolation{url: url, problems: problems}
+}
+
+func (v *contractViolation) Error() string {
+	return fmt.Sprintf("response to %s violates the public-concepts-api contract: %s", v.url, strings.Join(v.problems, "; "))
+}
+
+func isContractViolation(err error) bool {
+	var violation *contractViolation
+	return errors.As(err, &violation)
+}
+
+// validateConcept lists the problems of a concept: its id must be a thing URI, its apiUrl an absolute URL and its type an
+// ontology URI, and so must those of every related, broader and narrower concept, whose predicates must be URIs
+func validateConcept(concept ConceptApiResponse) []string {
+	problems := validateSummary("", concept.Concept)
+	relationships := []struct {
+		field   string
+		related []RelatedConcept
+	}{
+		{"relatedConcepts", concept.Related},
+		{"broaderConcepts", concept.Broader},
+		{"narrowerConcepts", concept.Narrower},
+	}
+	for _, relationship := range relationships {
+		for i, related := range relationship.related {
+			field := fmt.Sprintf("%s[%d].", relationship.field, i)
+			problems = append(problems, validateSummary(field+"concept.", related.Concept)...)
+			if !isAbsoluteURL(related.Predicate) {
+				problems = append(problems, fmt.Sprintf("%spredicate '%s' is not a URI", field, related.Predicate))
+			}
+		}
+	}
+	return problems
+}
+
+func validateSummary(field string, concept Concept) []string {
+	problems := []string{}
+	if !thingIDMatcher.MatchString(concept.ID) {
+		problems = append(problems, fmt.Sprintf("%sid '%s' is not a %s{uuid} or %s{uuid} URI", field, concept.ID, ftThing, thingsApiUrl))
+	}
+	if !isAbsoluteURL(concept.ApiURL) {
+		problems = append(problems, fmt.Sprintf("%sapiUrl '%s' is not an absolute URL", field, concept.ApiURL))
+	}
+	if !strings.HasPrefix(concept.Type, ontologyPrefix+"/") || !isAbsoluteURL(concept.Type) {
+		problems = append(problems, fmt.Sprintf("%stype '%s' is not an %s URI", field, concept.Type, ontologyPrefix))
+	}
+	return problems
+}
+
+func isAbsoluteURL(value string) bool {
+	parsed, err := url.Parse(value)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+const (
+	fetchFailedMessage       = "failed to return organisation"
+	contractViolationMessage = "public-concepts-api returned an invalid organisation"
+)
+
+// fetchErrorMessage is what clients are told of a failure to fetch an organisation, without the details of public-concepts-api
+func fetchErrorMessage(err error) string {
+	if isContractViolation(err) {
+		return contractViolationMessage
+	}
+	return fetchFailedMessage
+}
+
+// writeFetchError responds to a failure to fetch an organisation: a 502 when public-concepts-api broke its contract, or a 500
+func writeFetchError(w http.ResponseWriter, err error) {
+	if isContractViolation(err) {
+		w.WriteHeader(http.StatusBadGateway)
+	} else {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	w.Write(messageJSON(fetchErrorMessage(err)))
+}
@@ -0,0 +1,180 @@
+package organisations
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Financial-Times/public-organisations-api/v3/organisationspb"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateConcept(t *testing.T) {
+	valid := func() ConceptApiResponse {
+		return ConceptApiResponse{
+			Concept: Concept{
+				ID:     ftThing + graphQLOrganisationUUID,
+				ApiURL: "http://api.ft.com/organisations/" + graphQLOrganisationUUID,
+				Type:   "http://www.ft.com/ontology/organisation/Organisation",
+			},
+			Related: []RelatedConcept{{
+				Concept: Concept{
+					ID:     thingsApiUrl + graphQLParentUUID,
+					ApiURL: "http://api.ft.com/organisations/" + graphQLParentUUID,
+					Type:   "http://www.ft.com/ontology/organisation/Organisation",
+				},
+				Predicate: "http://www.ft.com/ontology/subOrganisationOf",
+			}},
+		}
+	}
+
+	type validationTestCase struct {
+		name     string
+		change   func(c *ConceptApiResponse)
+		expected []string
+	}
+	testCases := []validationTestCase{
+		{"Valid", func(c *ConceptApiResponse) {}, []string{}},
+		{"Malformed id", func(c *ConceptApiResponse) { c.ID = ftThing + "not-a-uuid" },
+			[]string{"id 'http://www.ft.com/thing/not-a-uuid' is not a http://www.ft.com/thing/{uuid} or http://api.ft.com/things/{uuid} URI"}},
+		{"Missing apiUrl", func(c *ConceptApiResponse) { c.ApiURL = "" },
+			[]string{"apiUrl '' is not an absolute URL"}},
+		{"Type outside the ontology", func(c *ConceptApiResponse) { c.Type = "Organisation" },
+			[]string{"type 'Organisation' is not an http://www.ft.com/ontology URI"}},
+		{"Incomplete related concept", func(c *ConceptApiResponse) {
+			c.Related[0].Concept.ApiURL = ""
+			c.Related[0].Predicate = "subOrganisationOf"
+		}, []string{
+			"relatedConcepts[0].concept.apiUrl '' is not an absolute URL",
+			"relatedConcepts[0].predicate 'subOrganisationOf' is not a URI",
+		}},
+		{"Incomplete narrower concept", func(c *ConceptApiResponse) {
+			c.Narrower = []RelatedConcept{{Concept: Concept{}, Predicate: "http://www.w3.org/2004/02/skos/core#narrower"}}
+		}, []string{
+			"narrowerConcepts[0].concept.id '' is not a http://www.ft.com/thing/{uuid} or http://api.ft.com/things/{uuid} URI",
+			"narrowerConcepts[0].concept.apiUrl '' is not an absolute URL",
+			"narrowerConcepts[0].concept.type '' is not an http://www.ft.com/ontology URI",
+		}},
+	}
+
+	for _, test := range testCases {
+		concept := valid()
+		test.change(&concept)
+		assert.Equal(t, test.expected, validateConcept(concept), test.name+" failed: problems do not match!")
+	}
+}
+
+func TestContractViolationResponses(t *testing.T) {
+	invalidOrganisation := `{
+		"id": "http://www.ft.com/thing/not-a-uuid",
+		"apiUrl": "http://api.ft.com/organisations/4e484678-cf47-4168-b844-6adb47f8eb58",
+		"type": "http://www.ft.com/ontology/organisation/Organisation"
+	}`
+
+	testCases := []testCase{
+		{
+			"Invalid organisation",
+			"/organisations/4e484678-cf47-4168-b844-6adb47f8eb58",
+			200,
+			invalidOrganisation,
+			nil,
+			502,
+			`{"message": "public-concepts-api returned an invalid organisation"}`,
+		},
+		{
+			"Organisation not found",
+			"/organisations/4e484678-cf47-4168-b844-6adb47f8eb58",
+			404,
+			"",
+			nil,
+			404,
+			`{"message": "organisation not found"}`,
+		},
+		{
+			"Upstream error",
+			"/organisations/4e484678-cf47-4168-b844-6adb47f8eb58",
+			500,
+			"",
+			nil,
+			500,
+			`{"message": "failed to return organisation"}`,
+		},
+	}
+
+	for _, test := range testCases {
+		router := mux.NewRouter()
+		bh := NewHandler(&mockHTTPClient{resp: test.clientBody, statusCode: test.clientCode, err: test.clientError}, "localhost:8080/concepts")
+		bh.RegisterHandlers(router)
+		violations := contractViolations.Count()
+
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", test.url, nil)
+		router.ServeHTTP(rr, req)
+
+		assert.Equal(t, test.expectedCode, rr.Code, test.name+" failed: status codes do not match!")
+		assert.Equal(t, test.expectedBody, rr.Body.String(), test.name+" failed: status body does not match!")
+		counted := int64(0)
+		if test.expectedCode == http.StatusBadGateway {
+			counted = 1
+		}
+		assert.Equal(t, counted, contractViolations.Count()-violations, test.name+" failed: contract violations do not match!")
+	}
+}
+
+func TestContractViolationsOfIndustryClassificationListing(t *testing.T) {
+	searchURI := "/concepts?industryClassification=38ee195d-ebdd-48a9-af4b-c8a322e7b04d&type=http%3A%2F%2Fwww.ft.com%2Fontology%2Forganisation%2FOrganisation"
+	testCases := []struct {
+		name string
+		body string
+	}{
+		{"Invalid json", `{"concepts": [`},
+		{"Organisation without apiUrl", `{"concepts": [{"id": "http://www.ft.com/thing/d6b12f0c-bf3f-4045-a07b-1e4e49103fd6", "type": "http://www.ft.com/ontology/organisation/Organisation"}]}`},
+	}
+
+	for _, test := range testCases {
+		router := mux.NewRouter()
+		bh := NewHandler(&mockRoutingHTTPClient{responses: map[string]mockResponse{searchURI: {statusCode: 200, body: test.body}}}, "")
+		bh.RegisterHandlers(router)
+		violations := contractViolations.Count()
+
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/organisations?industryClassification=38ee195d-ebdd-48a9-af4b-c8a322e7b04d", nil)
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, 502, rec.Code, test.name+" failed: status codes do not match!")
+		assert.Equal(t, `{"message": "public-concepts-api returned invalid organisations"}`, rec.Body.String(), test.name+" failed: status body does not match!")
+		assert.Equal(t, int64(1), contractViolations.Count()-violations, test.name+" failed: contract violations do not match!")
+	}
+}
+
+func TestContractViolationsOfGRPCAndGraphQL(t *testing.T) {
+	responses := graphQLResponses()
+	responses["/concepts/"+graphQLSubsidiaryUUID+"?showRelationship=related"] = mockResponse{statusCode: 200, body: `{
+		"id": "http://www.ft.com/thing/not-a-uuid",
+		"type": "http://www.ft.com/ontology/organisation/Organisation"
+	}`}
+	bh := NewHandler(&mockRoutingHTTPClient{responses: responses}, "")
+
+	client := organisationspb.NewOrganisationsClient(dialGRPC(t, &bh))
+	_, err := client.GetOrganisation(context.Background(), &organisationspb.GetOrganisationRequest{Uuid: graphQLSubsidiaryUUID})
+	assert.Equal(t, codes.Internal, status.Code(err), "GetOrganisation status codes do not match!")
+	assert.Equal(t, contractViolationMessage, status.Convert(err).Message(), "GetOrganisation messages do not match!")
+	batch, err := client.BatchGetOrganisations(context.Background(), &organisationspb.BatchGetOrganisationsRequest{Uuids: []string{graphQLParentUUID, graphQLSubsidiaryUUID}})
+	assert.NoError(t, err, "BatchGetOrganisations should not fail for one organisation")
+	assert.Equal(t, int32(codes.Internal), batch.GetResults()[1].GetCode(), "BatchGetOrganisations status codes do not match!")
+	assert.Equal(t, contractViolationMessage, batch.GetResults()[1].GetMessage(), "BatchGetOrganisations messages do not match!")
+
+	router := mux.NewRouter()
+	bh.RegisterHandlers(router)
+	rec := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/graphql", strings.NewReader(`{"query": "{ organisation(uuid: \"`+graphQLSubsidiaryUUID+`\") { id } }"}`))
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"message":"public-concepts-api returned an invalid organisation"`)
+	assert.NotContains(t, rec.Body.String(), "/concepts/", "GraphQL errors should not reveal public-concepts-api requests!")
+}
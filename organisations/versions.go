@@ -0,0 +1,126 @@
+package organisations
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Media types of the versioned organisation schemas
+const (
+	jsonMediaType = "application/json"
+	v1MediaType   = "application/vnd.ft.organisation.v1+json"
+	v2MediaType   = "application/vnd.ft.organisation.v2+json"
+)
+
+// schemaVersion is a version of the organisation response schema, with the media type it is sent as
+type schemaVersion struct {
+	version   int
+	mediaType string
+}
+
+// defaultVersion is sent to clients that do not ask for a versioned media type
+var defaultVersion = schemaVersion{version: 1, mediaType: jsonMediaType}
+
+// mediaRange is one media range of an Accept header with its weight
+type mediaRange struct {
+	mediaType string
+	quality   float64
+}
+
+// negotiateVersion picks the schema version from the Accept header: the most preferred supported media type,
+// the first listed when weights are equal. It returns false when the client accepts none of them.
+func negotiateVersion(r *http.Request) (schemaVersion, bool) {
+	header := r.Header.Get("Accept")
+	if strings.TrimSpace(header) == "" {
+		return defaultVersion, true
+	}
+	for _, accepted := range parseAccept(header) {
+		switch accepted.mediaType {
+		case v2MediaType:
+			return schemaVersion{version: 2, mediaType: v2MediaType}, true
+		case v1MediaType:
+			return schemaVersion{version: 1, mediaType: v1MediaType}, true
+		case jsonMediaType, "application/*", "*/*":
+			return defaultVersion, true
+		}
+	}
+	return schemaVersion{}, false
+}
+
+// parseAccept reads the media ranges of an Accept header, most preferred first. Ranges with a zero weight are left out.
+func parseAccept(header string) []mediaRange {
+	ranges := []mediaRange{}
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		mediaType := strings.ToLower(strings.TrimSpace(fields[0]))
+		if mediaType == "" {
+			continue
+		}
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64)
+				if err != nil {
+					q = 0
+				}
+				quality = q
+			}
+		}
+		if quality > 0 {
+			ranges = append(ranges, mediaRange{mediaType: mediaType, quality: quality})
+		}
+	}
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+	return ranges
+}
+
+// shape returns the organisation in the schema version. The organisation is not changed, as it may be cached.
+//
+// v1 is the established shape, and keeps only the fields it was first released with: every field added since is left
+// out, so that clients that do not ask for a version keep receiving the same body. v2 has every field, but only has
+// arrays for parents, primary parent first, and for financial instruments, and only has typedLabels in place of the
+// properName, shortName, hiddenLabel and formerNames fields.
+func (v schemaVersion) shape(organisation Organisation) Organisation {
+	if v.version == 2 {
+		if organisation.Parent != nil {
+			parents := []Parent{*organisation.Parent}
+			for _, parent := range organisation.ParentOrganisations {
+				if parent.ID != organisation.Parent.ID {
+					parents = append(parents, parent)
+				}
+			}
+			organisation.ParentOrganisations = parents
+		}
+		organisation.Parent = nil
+		organisation.FinancialInstrument = nil
+		organisation.ProperName = ""
+		organisation.ShortName = ""
+		organisation.HiddenLabel = ""
+		organisation.FormerNames = nil
+		return organisation
+	}
+	return Organisation{
+		Thing:                  organisation.Thing,
+		ProperName:             organisation.ProperName,
+		ShortName:              organisation.ShortName,
+		HiddenLabel:            organisation.HiddenLabel,
+		FormerNames:            organisation.FormerNames,
+		CountryCode:            organisation.CountryCode,
+		CountryOfIncorporation: organisation.CountryOfIncorporation,
+		PostalCode:             organisation.PostalCode,
+		YearFounded:            organisation.YearFounded,
+		Types:                  organisation.Types,
+		DirectType:             organisation.DirectType,
+		Labels:                 organisation.Labels,
+		LegalEntityIdentifier:  organisation.LegalEntityIdentifier,
+		Parent:                 organisation.Parent,
+		Subsidiaries:           organisation.Subsidiaries,
+		FinancialInstrument:    organisation.FinancialInstrument,
+		IsDeprecated:           organisation.IsDeprecated,
+	}
+}
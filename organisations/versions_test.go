@@ -0,0 +1,124 @@
+package organisations
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestSchemaVersions(t *testing.T) {
+	testCases := []struct {
+		name                string
+		accept              string
+		expectedCode        int
+		expectedContentType string
+		golden              string
+	}{
+		{"No Accept", "", 200, "application/json; charset=UTF-8", "organisation_v1.json"},
+		{"JSON", "application/json", 200, "application/json; charset=UTF-8", "organisation_v1.json"},
+		{"v1", "application/vnd.ft.organisation.v1+json", 200, "application/vnd.ft.organisation.v1+json; charset=UTF-8", "organisation_v1.json"},
+		{"v2", "application/vnd.ft.organisation.v2+json", 200, "application/vnd.ft.organisation.v2+json; charset=UTF-8", "organisation_v2.json"},
+		{"Weighted", "application/json;q=0.5, application/vnd.ft.organisation.v2+json", 200, "application/vnd.ft.organisation.v2+json; charset=UTF-8", "organisation_v2.json"},
+		{"Wildcard", "text/html, */*;q=0.1", 200, "application/json; charset=UTF-8", "organisation_v1.json"},
+		{"Not acceptable", "application/vnd.ft.organisation.v3+json", 406, "application/json; charset=UTF-8", ""},
+	}
+
+	for _, test := range testCases {
+		mockClient := &mockHTTPClient{resp: getCompleteOrganisationAsConcept, statusCode: 200}
+		router := mux.NewRouter()
+		bh := NewHandler(mockClient, "")
+		bh.RegisterHandlers(router)
+
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/organisations/7c5218a0-3755-463e-abbc-1a1632cfd1da", nil)
+		if test.accept != "" {
+			req.Header.Set("Accept", test.accept)
+		}
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, test.expectedCode, rec.Code, test.name+" failed: status codes do not match!")
+		assert.Equal(t, test.expectedContentType, rec.Header().Get("Content-Type"), test.name+" failed: content types do not match!")
+		assert.Contains(t, rec.Header()["Vary"], "Accept", test.name+" failed: Vary does not match!")
+		if test.golden == "" {
+			continue
+		}
+		assertGolden(t, test.golden, rec.Body.Bytes(), test.name)
+		if test.golden == "organisation_v1.json" {
+			assert.Equal(t, transformBody(getTransformedCompleteOrganisation), rec.Body.String(), test.name+" failed: body does not match the established v1 body!")
+		}
+	}
+}
+
+func TestVaryAcceptOnEveryResponse(t *testing.T) {
+	testCases := []struct {
+		name         string
+		url          string
+		accept       string
+		expectedCode int
+	}{
+		{"Not acceptable", "/organisations/7c5218a0-3755-463e-abbc-1a1632cfd1da", "application/vnd.ft.organisation.v3+json", 406},
+		{"Alias redirected", "/organisations/6fc8fbac-b4ee-11e8-a790-6c96cfdf3997", "", 301},
+		{"Identifier redirected", "/organisations?authority=FACTSET&identifierValue=000C7F-E", "", 302},
+		{"Identifier not acceptable", "/organisations?authority=FACTSET&identifierValue=000C7F-E", "application/vnd.ft.organisation.v3+json", 406},
+	}
+
+	for _, test := range testCases {
+		mockClient := &mockHTTPClient{resp: getCompleteOrganisationAsConcept, statusCode: 200}
+		router := mux.NewRouter()
+		bh := NewHandler(mockClient, "")
+		bh.RegisterHandlers(router)
+
+		rec := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", test.url, nil)
+		if test.accept != "" {
+			req.Header.Set("Accept", test.accept)
+		}
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, test.expectedCode, rec.Code, test.name+" failed: status codes do not match!")
+		assert.Equal(t, []string{"Accept"}, rec.Header()["Vary"], test.name+" failed: Vary does not match!")
+	}
+}
+
+// assertGolden compares a JSON body with the indented JSON of a golden file in testdata, rewriting it with -update
+func assertGolden(t *testing.T, golden string, body []byte, name string) {
+	indented := bytes.Buffer{}
+	if err := json.Indent(&indented, body, "", "  "); err != nil {
+		t.Fatalf("%s failed: body is not JSON: %v", name, err)
+	}
+	path := filepath.Join("testdata", golden)
+	if *update {
+		if err := ioutil.WriteFile(path, indented.Bytes(), 0644); err != nil {
+			t.Fatalf("%s failed: golden file could not be written: %v", name, err)
+		}
+	}
+	expected, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%s failed: golden file could not be read: %v", name, err)
+	}
+	assert.Equal(t, string(expected), indented.String(), name+" failed: body does not match "+golden+"!")
+}
+
+func TestV2ListsPrimaryParentFirst(t *testing.T) {
+	parents := []Parent{{Thing: Thing{ID: "first"}}, {Thing: Thing{ID: "primary"}}, {Thing: Thing{ID: "last"}}}
+	org := Organisation{Parent: &parents[1], ParentOrganisations: parents}
+
+	shaped := schemaVersion{version: 2, mediaType: v2MediaType}.shape(org)
+	assert.Nil(t, shaped.Parent)
+	ids := []string{}
+	for _, parent := range shaped.ParentOrganisations {
+		ids = append(ids, parent.ID)
+	}
+	assert.Equal(t, []string{"primary", "first", "last"}, ids)
+	assert.Equal(t, "first", org.ParentOrganisations[0].ID, "the organisation must not be changed")
+}
@@ -0,0 +1,4 @@
+// Package organisationspb holds the protobuf messages and gRPC service of public-organisations-api, generated from organisations.proto
+package organisationspb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative organisations.proto
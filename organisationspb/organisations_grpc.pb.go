@@ -0,0 +1,166 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: organisations.proto
+
+// Organisations served by public-organisations-api, mirroring the JSON returned by GET /organisations/{uuid}.
+// Regenerate the Go code with `go generate ./organisationspb` after changing this file.
+
+package organisationspb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Organisations_GetOrganisation_FullMethodName       = "/ft.organisations.v1.Organisations/GetOrganisation"
+	Organisations_BatchGetOrganisations_FullMethodName = "/ft.organisations.v1.Organisations/BatchGetOrganisations"
+)
+
+// OrganisationsClient is the client API for Organisations service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OrganisationsClient interface {
+	// GetOrganisation returns the organisation with the UUID. Alias UUIDs resolve to the canonical organisation.
+	GetOrganisation(ctx context.Context, in *GetOrganisationRequest, opts ...grpc.CallOption) (*GetOrganisationResponse, error)
+	// BatchGetOrganisations returns a result for each UUID, so that one invalid, missing or failing UUID does not fail the batch.
+	BatchGetOrganisations(ctx context.Context, in *BatchGetOrganisationsRequest, opts ...grpc.CallOption) (*BatchGetOrganisationsResponse, error)
+}
+
+type organisationsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrganisationsClient(cc grpc.ClientConnInterface) OrganisationsClient {
+	return &organisationsClient{cc}
+}
+
+func (c *organisationsClient) GetOrganisation(ctx context.Context, in *GetOrganisationRequest, opts ...grpc.CallOption) (*GetOrganisationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganisationResponse)
+	err := c.cc.Invoke(ctx, Organisations_GetOrganisation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organisationsClient) BatchGetOrganisations(ctx context.Context, in *BatchGetOrganisationsRequest, opts ...grpc.CallOption) (*BatchGetOrganisationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetOrganisationsResponse)
+	err := c.cc.Invoke(ctx, Organisations_BatchGetOrganisations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrganisationsServer is the server API for Organisations service.
+// All implementations must embed UnimplementedOrganisationsServer
+// for forward compatibility.
+type OrganisationsServer interface {
+	// GetOrganisation returns the organisation with the UUID. Alias UUIDs resolve to the canonical organisation.
+	GetOrganisation(context.Context, *GetOrganisationRequest) (*GetOrganisationResponse, error)
+	// BatchGetOrganisations returns a result for each UUID, so that one invalid, missing or failing UUID does not fail the batch.
+	BatchGetOrganisations(context.Context, *BatchGetOrganisationsRequest) (*BatchGetOrganisationsResponse, error)
+	mustEmbedUnimplementedOrganisationsServer()
+}
+
+// UnimplementedOrganisationsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOrganisationsServer struct{}
+
+func (UnimplementedOrganisationsServer) GetOrganisation(context.Context, *GetOrganisationRequest) (*GetOrganisationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganisation not implemented")
+}
+func (UnimplementedOrganisationsServer) BatchGetOrganisations(context.Context, *BatchGetOrganisationsRequest) (*BatchGetOrganisationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetOrganisations not implemented")
+}
+func (UnimplementedOrganisationsServer) mustEmbedUnimplementedOrganisationsServer() {}
+func (UnimplementedOrganisationsServer) testEmbeddedByValue()                       {}
+
+// UnsafeOrganisationsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrganisationsServer will
+// result in compilation errors.
+type UnsafeOrganisationsServer interface {
+	mustEmbedUnimplementedOrganisationsServer()
+}
+
+func RegisterOrganisationsServer(s grpc.ServiceRegistrar, srv OrganisationsServer) {
+	// If the following call pancis, it indicates UnimplementedOrganisationsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Organisations_ServiceDesc, srv)
+}
+
+func _Organisations_GetOrganisation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganisationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganisationsServer).GetOrganisation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organisations_GetOrganisation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganisationsServer).GetOrganisation(ctx, req.(*GetOrganisationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organisations_BatchGetOrganisations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetOrganisationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganisationsServer).BatchGetOrganisations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organisations_BatchGetOrganisations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganisationsServer).BatchGetOrganisations(ctx, req.(*BatchGetOrganisationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Organisations_ServiceDesc is the grpc.ServiceDesc for Organisations service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Organisations_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ft.organisations.v1.Organisations",
+	HandlerType: (*OrganisationsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOrganisation",
+			Handler:    _Organisations_GetOrganisation_Handler,
+		},
+		{
+			MethodName: "BatchGetOrganisations",
+			Handler:    _Organisations_BatchGetOrganisations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "organisations.proto",
+}